@@ -0,0 +1,57 @@
+package pargo
+
+import (
+	"context"
+	"net/http"
+)
+
+// Account is the Pardot account of the business unit the client is
+// connected to.
+// API usage is only returned for some account levels, and is zero
+// otherwise.
+type Account struct {
+	ID                   int    `json:"id"`
+	Company              string `json:"company"`
+	Level                string `json:"level"`
+	Website              string `json:"website"`
+	VanityDomain         string `json:"vanity_domain"`
+	PluginCampaignID     int    `json:"plugin_campaign_id"`
+	Phone                string `json:"phone"`
+	Country              string `json:"country"`
+	APICallsUsed         Int    `json:"api_calls_used"`
+	MaximumDailyAPICalls Int    `json:"maximum_daily_api_calls"`
+	CreatedAt            Time   `json:"created_at"`
+	UpdatedAt            Time   `json:"updated_at"`
+}
+
+// APICallsRemaining returns the API calls left today, or -1 if Pardot
+// did not return the limit.
+func (a Account) APICallsRemaining() int {
+	if a.MaximumDailyAPICalls == 0 {
+		return -1
+	}
+	return max(int(a.MaximumDailyAPICalls-a.APICallsUsed), 0)
+}
+
+// ReadAccount is an endpoint to read the account of the client, such as
+// to check it is connected to the intended business unit.
+type ReadAccount struct {
+	Placeholder *Account
+}
+
+// ReadAccount executes the endpoint with arguments.
+func (p *Pargo) ReadAccount(args ReadAccount) error {
+	body, err := p.do(context.Background(), args)
+	if err != nil {
+		return err
+	}
+	return readRecord(body, "account", args.Placeholder)
+}
+
+func (ReadAccount) Method() string {
+	return http.MethodGet
+}
+
+func (ReadAccount) Path() string {
+	return "account/" + version + "/do/read"
+}
@@ -0,0 +1,36 @@
+package pargo_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/brunoflores/pargo"
+)
+
+func TestReadAccount(t *testing.T) {
+	var got *http.Request
+	client := newCannedClient(func(req *http.Request) string {
+		got = req
+		return `{"account":{"id":12,"company":"Acme","level":"Pardot Advanced",
+			"website":"https://acme.com","api_calls_used":"24,500",
+			"maximum_daily_api_calls":"25000","created_at":"2015-06-01 10:00:00"}}`
+	})
+
+	var account pargo.Account
+	if err := client.ReadAccount(pargo.ReadAccount{Placeholder: &account}); err != nil {
+		t.Fatal(err)
+	}
+	if got.URL.Path != "/api/account/version/4/do/read" {
+		t.Fatalf("got %s", got.URL.Path)
+	}
+	if account.Level != "Pardot Advanced" || account.Website != "https://acme.com" ||
+		account.CreatedAt.Year() != 2015 {
+		t.Fatalf("got %+v", account)
+	}
+	if n := account.APICallsRemaining(); n != 500 {
+		t.Fatalf("got %d calls remaining; want 500", n)
+	}
+	if n := (pargo.Account{}).APICallsRemaining(); n != -1 {
+		t.Fatalf("got %d calls remaining without a limit; want -1", n)
+	}
+}
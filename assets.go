@@ -0,0 +1,229 @@
+package pargo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// Asset is the set of fields shared by marketing assets such as forms.
+// Fields an asset does not have are left empty.
+//
+// Form handlers are not among the assets: version 4 of the API has no
+// form handler object to query or read. Submissions to a form handler
+// go through FormHandlerClient instead.
+type Asset struct {
+	ID        int       `json:"id"`
+	Name      string    `json:"name"`
+	Campaign  *Campaign `json:"campaign,omitempty"`
+	URL       string    `json:"url"`
+	EmbedCode string    `json:"embedCode"`
+	CreatedAt Time      `json:"created_at"`
+	UpdatedAt Time      `json:"updated_at"`
+}
+
+// Form is a Pardot hosted form.
+type Form struct {
+	Asset
+}
+
+// CustomRedirect is a tracked link redirecting to Destination.
+type CustomRedirect struct {
+	Asset
+	Destination string `json:"destination"`
+	VanityURL   string `json:"vanity_url"`
+}
+
+// DynamicContent is content that varies with the prospect viewing it.
+type DynamicContent struct {
+	Asset
+	EmbedURL    string `json:"embedUrl"`
+	BaseContent string `json:"baseContent"`
+	BasedOn     string `json:"basedOn"`
+}
+
+// LandingPage is a Pardot hosted page.
+type LandingPage struct {
+	Asset
+	VanityURL string `json:"vanity_url"`
+}
+
+func (Form) object() string           { return "form" }
+func (CustomRedirect) object() string { return "customRedirect" }
+func (DynamicContent) object() string { return "dynamicContent" }
+func (LandingPage) object() string    { return "landingPage" }
+
+// asset is implemented by the asset types, naming their Pardot object.
+type asset interface {
+	Form | CustomRedirect | DynamicContent | LandingPage
+	object() string
+}
+
+// QueryAssets are the arguments to query a page of assets.
+type QueryAssets struct {
+	Offset, Limit int
+	QueryFilters
+}
+
+// queryAssets is the query endpoint of an asset object.
+type queryAssets struct {
+	object string
+	QueryAssets
+}
+
+func (queryAssets) Method() string {
+	return http.MethodGet
+}
+
+func (q queryAssets) Path() string {
+	return q.object + "/" + version + "/do/query"
+}
+
+func (q queryAssets) Query() (map[string]string, error) {
+	return q.pageQuery(q.Offset, q.Limit), nil
+}
+
+// readAsset is the read endpoint of an asset object.
+type readAsset struct {
+	object string
+	id     int
+}
+
+func (readAsset) Method() string {
+	return http.MethodGet
+}
+
+func (q readAsset) Path() string {
+	return fmt.Sprintf("%s/%s/do/read/id/%d", q.object, version, q.id)
+}
+
+func queryAsset[T asset](p *Pargo, q QueryAssets, placeholder *[]T) error {
+	var zero T
+	body, err := p.do(context.Background(), queryAssets{zero.object(), q})
+	if err != nil {
+		return err
+	}
+	assets, err := readRecords[T](body, zero.object())
+	if err != nil {
+		return err
+	}
+	*placeholder = append(*placeholder, assets...)
+	return nil
+}
+
+func readOneAsset[T asset](p *Pargo, id int, placeholder *T) error {
+	var zero T
+	body, err := p.do(context.Background(), readAsset{zero.object(), id})
+	if err != nil {
+		return err
+	}
+	return readRecord(body, zero.object(), placeholder)
+}
+
+// AssetsPager returns a Pager over every asset of type T matching q,
+// such as AssetsPager[Form].
+// The offset of q is ignored and its limit is the page size.
+func AssetsPager[T asset](p *Pargo, q QueryAssets) *Pager[T] {
+	var zero T
+	return NewPager[T](p, zero.object(), q.Limit,
+		func(offset, limit int) Endpoint {
+			q.Offset, q.Limit = offset, limit
+			return queryAssets{zero.object(), q}
+		})
+}
+
+// QueryAllAssets calls page serially with every asset of type T matching
+// q, a page at a time, such as QueryAllAssets[LandingPage].
+func QueryAllAssets[T asset](p *Pargo, q QueryAssets, page func([]T)) error {
+	return pageAll(context.Background(), AssetsPager[T](p, q), page)
+}
+
+// QueryForms is an endpoint to query a page of forms.
+type QueryForms struct {
+	QueryAssets
+	Placeholder *[]Form
+}
+
+// QueryForms executes the endpoint with arguments.
+func (p *Pargo) QueryForms(args QueryForms) error {
+	return queryAsset(p, args.QueryAssets, args.Placeholder)
+}
+
+// ReadForm is an endpoint to read a form by id.
+type ReadForm struct {
+	ID          int
+	Placeholder *Form
+}
+
+// ReadForm executes the endpoint with arguments.
+func (p *Pargo) ReadForm(args ReadForm) error {
+	return readOneAsset(p, args.ID, args.Placeholder)
+}
+
+// QueryCustomRedirects is an endpoint to query a page of custom
+// redirects.
+type QueryCustomRedirects struct {
+	QueryAssets
+	Placeholder *[]CustomRedirect
+}
+
+// QueryCustomRedirects executes the endpoint with arguments.
+func (p *Pargo) QueryCustomRedirects(args QueryCustomRedirects) error {
+	return queryAsset(p, args.QueryAssets, args.Placeholder)
+}
+
+// ReadCustomRedirect is an endpoint to read a custom redirect by id.
+type ReadCustomRedirect struct {
+	ID          int
+	Placeholder *CustomRedirect
+}
+
+// ReadCustomRedirect executes the endpoint with arguments.
+func (p *Pargo) ReadCustomRedirect(args ReadCustomRedirect) error {
+	return readOneAsset(p, args.ID, args.Placeholder)
+}
+
+// QueryDynamicContents is an endpoint to query a page of dynamic
+// content.
+type QueryDynamicContents struct {
+	QueryAssets
+	Placeholder *[]DynamicContent
+}
+
+// QueryDynamicContents executes the endpoint with arguments.
+func (p *Pargo) QueryDynamicContents(args QueryDynamicContents) error {
+	return queryAsset(p, args.QueryAssets, args.Placeholder)
+}
+
+// ReadDynamicContent is an endpoint to read dynamic content by id.
+type ReadDynamicContent struct {
+	ID          int
+	Placeholder *DynamicContent
+}
+
+// ReadDynamicContent executes the endpoint with arguments.
+func (p *Pargo) ReadDynamicContent(args ReadDynamicContent) error {
+	return readOneAsset(p, args.ID, args.Placeholder)
+}
+
+// QueryLandingPages is an endpoint to query a page of landing pages.
+type QueryLandingPages struct {
+	QueryAssets
+	Placeholder *[]LandingPage
+}
+
+// QueryLandingPages executes the endpoint with arguments.
+func (p *Pargo) QueryLandingPages(args QueryLandingPages) error {
+	return queryAsset(p, args.QueryAssets, args.Placeholder)
+}
+
+// ReadLandingPage is an endpoint to read a landing page by id.
+type ReadLandingPage struct {
+	ID          int
+	Placeholder *LandingPage
+}
+
+// ReadLandingPage executes the endpoint with arguments.
+func (p *Pargo) ReadLandingPage(args ReadLandingPage) error {
+	return readOneAsset(p, args.ID, args.Placeholder)
+}
@@ -0,0 +1,112 @@
+package pargo_test
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/brunoflores/pargo"
+)
+
+func TestReadAssets(t *testing.T) {
+	var got *http.Request
+	client := newCannedClient(func(req *http.Request) string {
+		got = req
+		switch {
+		case strings.HasPrefix(req.URL.Path, "/api/form/"):
+			return `{"form":{"id":1,"name":"Contact","embedCode":"<iframe/>",
+				"campaign":{"id":4,"name":"Spring"}}}`
+		case strings.HasPrefix(req.URL.Path, "/api/customRedirect/"):
+			return `{"customRedirect":{"id":2,"url":"https://go.x.com/a",
+				"destination":"https://x.com","vanity_url":"https://x.com/a"}}`
+		case strings.HasPrefix(req.URL.Path, "/api/dynamicContent/"):
+			return `{"dynamicContent":{"id":3,"embedUrl":"https://x.com/dc",
+				"basedOn":"Country"}}`
+		}
+		return `{"landingPage":{"id":4,"url":"https://x.com/lp"}}`
+	})
+
+	var form pargo.Form
+	var redirect pargo.CustomRedirect
+	var content pargo.DynamicContent
+	var page pargo.LandingPage
+	tests := []struct {
+		call func() error
+		path string
+	}{
+		{func() error {
+			return client.ReadForm(pargo.ReadForm{ID: 1, Placeholder: &form})
+		}, "/api/form/version/4/do/read/id/1"},
+		{func() error {
+			return client.ReadCustomRedirect(pargo.ReadCustomRedirect{ID: 2, Placeholder: &redirect})
+		}, "/api/customRedirect/version/4/do/read/id/2"},
+		{func() error {
+			return client.ReadDynamicContent(pargo.ReadDynamicContent{ID: 3, Placeholder: &content})
+		}, "/api/dynamicContent/version/4/do/read/id/3"},
+		{func() error {
+			return client.ReadLandingPage(pargo.ReadLandingPage{ID: 4, Placeholder: &page})
+		}, "/api/landingPage/version/4/do/read/id/4"},
+	}
+	for _, test := range tests {
+		if err := test.call(); err != nil {
+			t.Fatal(err)
+		}
+		if got.URL.Path != test.path {
+			t.Errorf("got %s; want %s", got.URL.Path, test.path)
+		}
+	}
+	if form.EmbedCode != "<iframe/>" || form.Campaign == nil || form.Campaign.ID != 4 {
+		t.Errorf("got form %+v", form)
+	}
+	if redirect.Destination != "https://x.com" || redirect.URL != "https://go.x.com/a" {
+		t.Errorf("got redirect %+v", redirect)
+	}
+	if content.BasedOn != "Country" || content.EmbedURL != "https://x.com/dc" {
+		t.Errorf("got content %+v", content)
+	}
+	if page.ID != 4 || page.URL != "https://x.com/lp" {
+		t.Errorf("got page %+v", page)
+	}
+}
+
+func TestQueryAllAssets(t *testing.T) {
+	// Forms 1 to 230.
+	client := newCannedClient(func(req *http.Request) string {
+		if got := req.URL.Path; got != "/api/form/version/4/do/query" {
+			t.Errorf("got path %q", got)
+		}
+		offset, _ := strconv.Atoi(req.FormValue("offset"))
+		limit, _ := strconv.Atoi(req.FormValue("limit"))
+		var page []string
+		for id := offset + 1; id <= 230 && len(page) < limit; id++ {
+			page = append(page, fmt.Sprintf(`{"id":%d,"name":"form %d"}`, id, id))
+		}
+		return `{"result":{"form":[` + strings.Join(page, ",") + `]}}`
+	})
+
+	var ids []int
+	err := pargo.QueryAllAssets(client, pargo.QueryAssets{},
+		func(forms []pargo.Form) {
+			for _, f := range forms {
+				ids = append(ids, f.ID)
+			}
+		})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ids) != 230 || ids[229] != 230 {
+		t.Fatalf("got %d forms", len(ids))
+	}
+
+	var forms []pargo.Form
+	err = client.QueryForms(pargo.QueryForms{
+		QueryAssets: pargo.QueryAssets{Offset: 200, Limit: 200}, Placeholder: &forms})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(forms) != 30 {
+		t.Fatalf("got %d forms", len(forms))
+	}
+}
@@ -0,0 +1,208 @@
+package pargo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+)
+
+// Campaign is a marketing campaign.
+// Records nesting a campaign, such as visitor activities, only have its
+// ID and Name.
+type Campaign struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+	Cost int    `json:"cost,omitempty"`
+}
+
+// QueryCampaigns is an endpoint to query a page of campaigns.
+type QueryCampaigns struct {
+	Offset, Limit int
+	QueryFilters
+
+	// Name matches campaigns whose name contains it.
+	Name string
+
+	Placeholder *[]Campaign
+}
+
+// QueryCampaigns executes the endpoint with arguments.
+func (p *Pargo) QueryCampaigns(args QueryCampaigns) error {
+	body, err := p.do(context.Background(), args)
+	if err != nil {
+		return err
+	}
+	campaigns, err := readRecords[Campaign](body, "campaign")
+	if err != nil {
+		return err
+	}
+	*args.Placeholder = append(*args.Placeholder, campaigns...)
+	return nil
+}
+
+func (QueryCampaigns) Method() string {
+	return http.MethodGet
+}
+
+func (QueryCampaigns) Path() string {
+	return "campaign/" + version + "/do/query"
+}
+
+func (q QueryCampaigns) Query() (map[string]string, error) {
+	query := q.pageQuery(q.Offset, q.Limit)
+	addString(query, "name", q.Name)
+	return query, nil
+}
+
+// QueryCampaignsPager returns a Pager over every campaign matching q.
+// The offset of q is ignored and its limit is the page size.
+func (p *Pargo) QueryCampaignsPager(q QueryCampaigns) *Pager[Campaign] {
+	return NewPager[Campaign](p, "campaign", q.Limit,
+		func(offset, limit int) Endpoint {
+			q.Offset, q.Limit = offset, limit
+			return q
+		})
+}
+
+// ReadCampaign is an endpoint to read a campaign by id.
+type ReadCampaign struct {
+	ID          int
+	Placeholder *Campaign
+}
+
+// ReadCampaign executes the endpoint with arguments.
+func (p *Pargo) ReadCampaign(args ReadCampaign) error {
+	body, err := p.do(context.Background(), args)
+	if err != nil {
+		return err
+	}
+	return readRecord(body, "campaign", args.Placeholder)
+}
+
+func (ReadCampaign) Method() string {
+	return http.MethodGet
+}
+
+func (q ReadCampaign) Path() string {
+	return fmt.Sprintf("campaign/%s/do/read/id/%d", version, q.ID)
+}
+
+// CreateCampaign is an endpoint to create a campaign.
+// The optional Placeholder receives the campaign created.
+type CreateCampaign struct {
+	Name        string
+	Cost        int
+	Placeholder *Campaign
+}
+
+// CreateCampaign executes the endpoint with arguments.
+func (p *Pargo) CreateCampaign(args CreateCampaign) error {
+	body, err := p.do(context.Background(), args)
+	if err != nil {
+		return err
+	}
+	return readRecord(body, "campaign", args.Placeholder)
+}
+
+func (CreateCampaign) Method() string {
+	return http.MethodPost
+}
+
+func (CreateCampaign) Path() string {
+	return "campaign/" + version + "/do/create"
+}
+
+func (q CreateCampaign) Query() (map[string]string, error) {
+	return map[string]string{
+		"name": q.Name,
+		"cost": strconv.Itoa(q.Cost),
+	}, nil
+}
+
+// UpdateCampaign is an endpoint to update a campaign by id.
+// An empty Name and a nil Cost are left unchanged.
+// The optional Placeholder receives the campaign updated.
+type UpdateCampaign struct {
+	ID          int
+	Name        string
+	Cost        *int
+	Placeholder *Campaign
+}
+
+// UpdateCampaign executes the endpoint with arguments.
+func (p *Pargo) UpdateCampaign(args UpdateCampaign) error {
+	body, err := p.do(context.Background(), args)
+	if err != nil {
+		return err
+	}
+	return readRecord(body, "campaign", args.Placeholder)
+}
+
+func (UpdateCampaign) Method() string {
+	return http.MethodPost
+}
+
+func (q UpdateCampaign) Path() string {
+	return fmt.Sprintf("campaign/%s/do/update/id/%d", version, q.ID)
+}
+
+func (q UpdateCampaign) Query() (map[string]string, error) {
+	query := make(map[string]string)
+	addString(query, "name", q.Name)
+	if q.Cost != nil {
+		query["cost"] = strconv.Itoa(*q.Cost)
+	}
+	return query, nil
+}
+
+// CampaignNames resolves campaign ids to names, reading each campaign
+// from Pardot only the first time it is asked for.
+// It is safe for concurrent use.
+type CampaignNames struct {
+	client *Pargo
+	mu     sync.Mutex
+	names  map[int]string
+}
+
+// NewCampaignNames returns an empty cache of campaign names.
+func NewCampaignNames(p *Pargo) *CampaignNames {
+	return &CampaignNames{client: p, names: make(map[int]string)}
+}
+
+// Name returns the name of the campaign.
+func (c *CampaignNames) Name(id int) (string, error) {
+	c.mu.Lock()
+	name, ok := c.names[id]
+	c.mu.Unlock()
+	if ok {
+		return name, nil
+	}
+	var campaign Campaign
+	err := c.client.ReadCampaign(ReadCampaign{ID: id, Placeholder: &campaign})
+	if err != nil {
+		return "", err
+	}
+	c.mu.Lock()
+	c.names[id] = campaign.Name
+	c.mu.Unlock()
+	return campaign.Name, nil
+}
+
+// Resolve sets the campaign of each prospect with a CampaignID, such as
+// returned by queries, to the campaign with its name.
+func (c *CampaignNames) Resolve(prospects []Prospect) error {
+	for i := range prospects {
+		id := prospects[i].CampaignID
+		if id == 0 {
+			continue
+		}
+		name, err := c.Name(id)
+		if err != nil {
+			return err
+		}
+		prospects[i].Campaign = &Campaign{ID: id, Name: name}
+	}
+	return nil
+}
@@ -0,0 +1,105 @@
+package pargo_test
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/brunoflores/pargo"
+)
+
+func TestCampaignCRUD(t *testing.T) {
+	var got *http.Request
+	client := newCannedClient(func(req *http.Request) string {
+		got = req
+		if strings.HasSuffix(req.URL.Path, "/query") {
+			return `{"result":{"total_results":1,"campaign":{"id":4,"name":"Spring"}}}`
+		}
+		return `{"campaign":{"id":4,"name":"Spring","cost":150}}`
+	})
+
+	var campaigns []pargo.Campaign
+	err := client.QueryCampaigns(pargo.QueryCampaigns{
+		Limit: 200, Name: "Spr", Placeholder: &campaigns})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.FormValue("name") != "Spr" || len(campaigns) != 1 || campaigns[0].ID != 4 {
+		t.Fatalf("name = %q; got %+v", got.FormValue("name"), campaigns)
+	}
+
+	var campaign pargo.Campaign
+	cost := 200
+	checkEndpointCalls(t, &got, []endpointCall{
+		{
+			func() error {
+				return client.ReadCampaign(pargo.ReadCampaign{ID: 4, Placeholder: &campaign})
+			},
+			"GET", "/api/campaign/version/4/do/read/id/4", nil,
+		},
+		{
+			func() error {
+				return client.CreateCampaign(pargo.CreateCampaign{Name: "Spring", Cost: 150})
+			},
+			"POST", "/api/campaign/version/4/do/create",
+			map[string]string{"name": "Spring", "cost": "150"},
+		},
+		{
+			func() error {
+				return client.UpdateCampaign(pargo.UpdateCampaign{ID: 4, Cost: &cost})
+			},
+			"POST", "/api/campaign/version/4/do/update/id/4",
+			map[string]string{"cost": "200"},
+		},
+	})
+	if campaign.Cost != 150 || campaign.Name != "Spring" {
+		t.Fatalf("got %+v", campaign)
+	}
+}
+
+func TestCampaignNames(t *testing.T) {
+	var mu sync.Mutex
+	reads := make(map[string]int)
+	client := newCannedClient(func(req *http.Request) string {
+		if strings.HasSuffix(req.URL.Path, "/prospect/version/4/do/query") {
+			return `{"result":{"prospect":[{"id":1,"campaign_id":4},` +
+				`{"id":2,"campaign_id":5},{"id":3,"campaign_id":4},{"id":4}]}}`
+		}
+		id := req.URL.Path[strings.LastIndex(req.URL.Path, "/")+1:]
+		mu.Lock()
+		reads[id]++
+		mu.Unlock()
+		return fmt.Sprintf(`{"campaign":{"id":%s,"name":"Campaign %s"}}`, id, id)
+	})
+
+	var prospects []pargo.Prospect
+	err := client.QueryProspects(pargo.QueryProspects{
+		Limit:       200,
+		Fields:      []string{"id", "campaign_id"},
+		PlaceHolder: &prospects,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	names := pargo.NewCampaignNames(client)
+	if err := names.Resolve(prospects); err != nil {
+		t.Fatal(err)
+	}
+	for _, p := range prospects[:3] {
+		want := fmt.Sprintf("Campaign %d", p.CampaignID)
+		if p.Campaign == nil || p.Campaign.Name != want {
+			t.Errorf("prospect %d: got campaign %+v; want %q", p.ID, p.Campaign, want)
+		}
+	}
+	if prospects[3].Campaign != nil {
+		t.Errorf("prospect without campaign got %+v", prospects[3].Campaign)
+	}
+	if name, err := names.Name(5); err != nil || name != "Campaign 5" {
+		t.Fatalf("got %q, %v", name, err)
+	}
+	if reads["4"] != 1 || reads["5"] != 1 {
+		t.Fatalf("got reads %v; want one per campaign", reads)
+	}
+}
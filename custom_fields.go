@@ -0,0 +1,346 @@
+package pargo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Custom field types, as named by Pardot.
+const (
+	CustomFieldText        = "Text"
+	CustomFieldNumber      = "Number"
+	CustomFieldDate        = "Date"
+	CustomFieldRadioButton = "Radio Button"
+	CustomFieldCheckbox    = "Checkbox"
+	CustomFieldDropdown    = "Dropdown"
+	CustomFieldMultiSelect = "Multi-Select"
+	CustomFieldTextArea    = "Textarea"
+	CustomFieldHidden      = "Hidden"
+	CustomFieldCRMUser     = "CRM User"
+)
+
+// CustomField is a field added to prospects by the account.
+// FieldID is the name of the field in the API, such as in the fields of
+// QueryProspects.
+type CustomField struct {
+	ID                        int    `json:"id"`
+	Name                      string `json:"name"`
+	FieldID                   string `json:"field_id"`
+	Type                      string `json:"type"`
+	TypeID                    int    `json:"type_id"`
+	IsRecordMultipleResponses Bool   `json:"is_record_multiple_responses"`
+	IsUseValues               Bool   `json:"is_use_values"`
+	CRMID                     string `json:"crm_id"`
+	CreatedAt                 Time   `json:"created_at"`
+	UpdatedAt                 Time   `json:"updated_at"`
+}
+
+// QueryCustomFields is an endpoint to query a page of custom fields.
+type QueryCustomFields struct {
+	Offset, Limit int
+	QueryFilters
+	Placeholder *[]CustomField
+}
+
+// QueryCustomFields executes the endpoint with arguments.
+func (p *Pargo) QueryCustomFields(args QueryCustomFields) error {
+	body, err := p.do(context.Background(), args)
+	if err != nil {
+		return err
+	}
+	fields, err := readRecords[CustomField](body, "customField")
+	if err != nil {
+		return err
+	}
+	*args.Placeholder = append(*args.Placeholder, fields...)
+	return nil
+}
+
+func (QueryCustomFields) Method() string {
+	return http.MethodGet
+}
+
+func (QueryCustomFields) Path() string {
+	return "customField/" + version + "/do/query"
+}
+
+func (q QueryCustomFields) Query() (map[string]string, error) {
+	return q.pageQuery(q.Offset, q.Limit), nil
+}
+
+// QueryCustomFieldsPager returns a Pager over every custom field
+// matching q. The offset of q is ignored and its limit is the page size.
+func (p *Pargo) QueryCustomFieldsPager(q QueryCustomFields) *Pager[CustomField] {
+	return NewPager[CustomField](p, "customField", q.Limit,
+		func(offset, limit int) Endpoint {
+			q.Offset, q.Limit = offset, limit
+			return q
+		})
+}
+
+// ReadCustomField is an endpoint to read a custom field by id.
+type ReadCustomField struct {
+	ID          int
+	Placeholder *CustomField
+}
+
+// ReadCustomField executes the endpoint with arguments.
+func (p *Pargo) ReadCustomField(args ReadCustomField) error {
+	body, err := p.do(context.Background(), args)
+	if err != nil {
+		return err
+	}
+	return readRecord(body, "customField", args.Placeholder)
+}
+
+func (ReadCustomField) Method() string {
+	return http.MethodGet
+}
+
+func (q ReadCustomField) Path() string {
+	return fmt.Sprintf("customField/%s/do/read/id/%d", version, q.ID)
+}
+
+// CreateCustomField is an endpoint to create a custom field.
+// The optional Placeholder receives the field created.
+type CreateCustomField struct {
+	Name                      string
+	FieldID                   string
+	Type                      string
+	IsRecordMultipleResponses bool
+	Placeholder               *CustomField
+}
+
+// CreateCustomField executes the endpoint with arguments.
+func (p *Pargo) CreateCustomField(args CreateCustomField) error {
+	body, err := p.do(context.Background(), args)
+	if err != nil {
+		return err
+	}
+	return readRecord(body, "customField", args.Placeholder)
+}
+
+func (CreateCustomField) Method() string {
+	return http.MethodPost
+}
+
+func (CreateCustomField) Path() string {
+	return "customField/" + version + "/do/create"
+}
+
+func (q CreateCustomField) Query() (map[string]string, error) {
+	query := map[string]string{
+		"name": q.Name,
+		"is_record_multiple_responses": strconv.FormatBool(
+			q.IsRecordMultipleResponses),
+	}
+	addString(query, "field_id", q.FieldID)
+	addString(query, "type", q.Type)
+	return query, nil
+}
+
+// UpdateCustomField is an endpoint to update a custom field by id.
+// Empty and nil fields are left unchanged.
+// The optional Placeholder receives the field updated.
+type UpdateCustomField struct {
+	ID                        int
+	Name, FieldID, Type       string
+	IsRecordMultipleResponses *bool
+	Placeholder               *CustomField
+}
+
+// UpdateCustomField executes the endpoint with arguments.
+func (p *Pargo) UpdateCustomField(args UpdateCustomField) error {
+	body, err := p.do(context.Background(), args)
+	if err != nil {
+		return err
+	}
+	return readRecord(body, "customField", args.Placeholder)
+}
+
+func (UpdateCustomField) Method() string {
+	return http.MethodPost
+}
+
+func (q UpdateCustomField) Path() string {
+	return fmt.Sprintf("customField/%s/do/update/id/%d", version, q.ID)
+}
+
+func (q UpdateCustomField) Query() (map[string]string, error) {
+	query := make(map[string]string)
+	addString(query, "name", q.Name)
+	addString(query, "field_id", q.FieldID)
+	addString(query, "type", q.Type)
+	addBool(query, "is_record_multiple_responses", q.IsRecordMultipleResponses)
+	return query, nil
+}
+
+// DeleteCustomField is an endpoint to delete a custom field by id.
+type DeleteCustomField struct {
+	ID int
+}
+
+// DeleteCustomField executes the endpoint with arguments.
+func (p *Pargo) DeleteCustomField(args DeleteCustomField) error {
+	_, err := p.do(context.Background(), args)
+	return err
+}
+
+func (DeleteCustomField) Method() string {
+	return http.MethodPost
+}
+
+func (q DeleteCustomField) Path() string {
+	return fmt.Sprintf("customField/%s/do/delete/id/%d", version, q.ID)
+}
+
+// ProspectSchema is the set of custom fields of prospects, by FieldID.
+// It decodes prospects with their custom values converted to Go types
+// according to the type of each field.
+type ProspectSchema map[string]CustomField
+
+// LoadProspectSchema reads every custom field of the account.
+func (p *Pargo) LoadProspectSchema(ctx context.Context) (ProspectSchema, error) {
+	schema := make(ProspectSchema)
+	pager := p.QueryCustomFieldsPager(QueryCustomFields{})
+	for field, err := range pager.All(ctx) {
+		if err != nil {
+			return nil, err
+		}
+		schema[field.FieldID] = field
+	}
+	return schema, nil
+}
+
+// Fields returns the API names of every custom field, to be requested
+// along with standard fields.
+func (s ProspectSchema) Fields() []string {
+	fields := make([]string, 0, len(s))
+	for id := range s {
+		fields = append(fields, id)
+	}
+	return fields
+}
+
+// DecodeProspects decodes a page of prospects, such as given by the
+// Marshaler of QueryProspects or the Page of QueryAllProspects.
+func (s ProspectSchema) DecodeProspects(page json.RawMessage) ([]Prospect, error) {
+	records, err := splitPage(page)
+	if err != nil {
+		return nil, err
+	}
+	prospects := make([]Prospect, len(records))
+	for i, r := range records {
+		if err := s.DecodeProspect(r, &prospects[i]); err != nil {
+			return nil, err
+		}
+	}
+	return prospects, nil
+}
+
+// DecodeProspect decodes a prospect, setting Custom to the value of each
+// custom field in the schema:
+//   - Number fields are float64;
+//   - Date fields are time.Time;
+//   - fields recording multiple responses, and Checkbox and
+//     Multi-Select fields, are []string;
+//   - any other field is a string.
+//
+// Empty values are left out.
+func (s ProspectSchema) DecodeProspect(raw json.RawMessage, p *Prospect) error {
+	if err := json.Unmarshal(raw, p); err != nil {
+		return errors.Wrap(err, "unmarshaling prospect")
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		return errors.Wrap(err, "unmarshaling prospect")
+	}
+	for id, value := range fields {
+		field, ok := s[id]
+		if !ok {
+			continue
+		}
+		v, err := field.decode(value)
+		if err != nil {
+			return errors.Wrapf(err, "reading custom field %s", id)
+		}
+		if v == nil {
+			continue
+		}
+		if p.Custom == nil {
+			p.Custom = make(map[string]interface{})
+		}
+		p.Custom[id] = v
+	}
+	return nil
+}
+
+// decode converts a value of the field, returning nil if empty.
+func (f CustomField) decode(raw json.RawMessage) (interface{}, error) {
+	values, err := customValues(raw)
+	if err != nil || len(values) == 0 {
+		return nil, err
+	}
+	switch {
+	case bool(f.IsRecordMultipleResponses),
+		f.Type == CustomFieldCheckbox,
+		f.Type == CustomFieldMultiSelect:
+		return values, nil
+	case f.Type == CustomFieldNumber:
+		n, err := strconv.ParseFloat(values[0], 64)
+		if err != nil {
+			return nil, errors.Wrap(err, "parsing number")
+		}
+		return n, nil
+	case f.Type == CustomFieldDate:
+		for _, layout := range []string{"2006-01-02", timeLayout} {
+			if t, err := time.Parse(layout, values[0]); err == nil {
+				return t, nil
+			}
+		}
+		return nil, errors.Errorf("parsing date %q", values[0])
+	}
+	return strings.Join(values, ", "), nil
+}
+
+// customValues reads the values of a custom field, which Pardot returns
+// as a string, a number, an array, or an object with the key "value"
+// holding any of those.
+func customValues(raw json.RawMessage) ([]string, error) {
+	var nested struct {
+		Value json.RawMessage `json:"value"`
+	}
+	if json.Unmarshal(raw, &nested) == nil && nested.Value != nil {
+		raw = nested.Value
+	}
+	var many []json.RawMessage
+	if err := json.Unmarshal(raw, &many); err != nil {
+		many = []json.RawMessage{raw}
+	}
+	var values []string
+	for _, m := range many {
+		var v interface{}
+		if err := json.Unmarshal(m, &v); err != nil {
+			return nil, errors.Wrap(err, "unmarshaling value")
+		}
+		switch v := v.(type) {
+		case nil:
+		case string:
+			if v != "" {
+				values = append(values, v)
+			}
+		case float64:
+			values = append(values, formatFloat(v))
+		default:
+			values = append(values, fmt.Sprint(v))
+		}
+	}
+	return values, nil
+}
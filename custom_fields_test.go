@@ -0,0 +1,116 @@
+package pargo_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/brunoflores/pargo"
+)
+
+func TestCustomFieldCRUD(t *testing.T) {
+	var got *http.Request
+	client := newCannedClient(func(req *http.Request) string {
+		got = req
+		return `{"customField":{"id":3,"name":"Region","field_id":"region",` +
+			`"type":"Dropdown","is_record_multiple_responses":false}}`
+	})
+	var field pargo.CustomField
+	multiple := true
+	checkEndpointCalls(t, &got, []endpointCall{
+		{
+			func() error {
+				return client.ReadCustomField(pargo.ReadCustomField{ID: 3, Placeholder: &field})
+			},
+			"GET", "/api/customField/version/4/do/read/id/3", nil,
+		},
+		{
+			func() error {
+				return client.CreateCustomField(pargo.CreateCustomField{
+					Name: "Region", FieldID: "region", Type: pargo.CustomFieldDropdown})
+			},
+			"POST", "/api/customField/version/4/do/create",
+			map[string]string{"name": "Region", "field_id": "region",
+				"type": "Dropdown", "is_record_multiple_responses": "false"},
+		},
+		{
+			func() error {
+				return client.UpdateCustomField(pargo.UpdateCustomField{
+					ID: 3, IsRecordMultipleResponses: &multiple})
+			},
+			"POST", "/api/customField/version/4/do/update/id/3",
+			map[string]string{"is_record_multiple_responses": "true"},
+		},
+		{
+			func() error {
+				return client.DeleteCustomField(pargo.DeleteCustomField{ID: 3})
+			},
+			"POST", "/api/customField/version/4/do/delete/id/3", nil,
+		},
+	})
+	if field.FieldID != "region" || field.Type != pargo.CustomFieldDropdown {
+		t.Fatalf("got %+v", field)
+	}
+}
+
+func TestProspectSchema(t *testing.T) {
+	const fields = `{"result":{"customField":[` +
+		`{"field_id":"employees","type":"Number"},` +
+		`{"field_id":"renewal","type":"Date"},` +
+		`{"field_id":"products","type":"Multi-Select"},` +
+		`{"field_id":"sources","type":"Text","is_record_multiple_responses":"1"},` +
+		`{"field_id":"region","type":"Dropdown"}]}}`
+	client := newCannedClient(func(req *http.Request) string {
+		if !strings.Contains(req.URL.Path, "/customField/") {
+			t.Errorf("unexpected request %q", req.URL.Path)
+		}
+		return fields
+	})
+	schema, err := client.LoadProspectSchema(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(schema.Fields()) != 5 {
+		t.Fatalf("got fields %v", schema.Fields())
+	}
+
+	page := json.RawMessage(`[{"id":1,"email":"a@b.com",` +
+		`"employees":"1200","renewal":"2021-06-30",` +
+		`"products":{"value":["CRM","Mail"]},"sources":{"value":"Web"},` +
+		`"region":"APAC","unknown":"x"},` +
+		`{"id":2,"employees":12.5,"renewal":"","products":"CRM","region":null}]`)
+	prospects, err := schema.DecodeProspects(page)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []map[string]interface{}{
+		{
+			"employees": 1200.0,
+			"renewal":   time.Date(2021, 6, 30, 0, 0, 0, 0, time.UTC),
+			"products":  []string{"CRM", "Mail"},
+			"sources":   []string{"Web"},
+			"region":    "APAC",
+		},
+		{
+			"employees": 12.5,
+			"products":  []string{"CRM"},
+		},
+	}
+	for i, p := range prospects {
+		if !reflect.DeepEqual(p.Custom, want[i]) {
+			t.Errorf("prospect %d: got %#v; want %#v", p.ID, p.Custom, want[i])
+		}
+	}
+	if prospects[0].Email != "a@b.com" {
+		t.Errorf("got %+v", prospects[0])
+	}
+
+	_, err = schema.DecodeProspects(json.RawMessage(`{"id":3,"employees":"many"}`))
+	if err == nil {
+		t.Fatal("want error for a bad number; got nil")
+	}
+}
@@ -33,9 +33,12 @@ func TestDeleteProspect(t *testing.T) {
 	})
 	want := "/api/prospect/version/4/do/delete/id/46"
 	client := newTestClient(testClient)
-	client.DeleteProspect(pargo.DeleteProspect{
+	err := client.DeleteProspect(pargo.DeleteProspect{
 		ProspectID: 46,
 	})
+	if err != nil {
+		t.Fatal(err)
+	}
 	if got != want {
 		t.Fatalf("got %q; want %q", got, want)
 	}
@@ -0,0 +1,109 @@
+package pargo
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// EmailClick is a click by a prospect on a tracked link of an email.
+// Only one of ListEmailID, DripProgramActionID and EmailTemplateID is
+// set, depending on how the email was sent.
+type EmailClick struct {
+	ID                  int    `json:"id"`
+	ProspectID          int    `json:"prospect_id"`
+	URL                 string `json:"url"`
+	ListEmailID         int    `json:"list_email_id"`
+	DripProgramActionID int    `json:"drip_program_action_id"`
+	EmailTemplateID     int    `json:"email_template_id"`
+	TrackerRedirectID   int    `json:"tracker_redirect_id"`
+	CreatedAt           Time   `json:"created_at"`
+}
+
+// QueryEmailClicks is an endpoint to query a page of email clicks,
+// which Pardot returns by ascending id.
+type QueryEmailClicks struct {
+	Offset, Limit int
+
+	// Optional filters.
+	// Zero values are not sent to Pardot.
+	IDGreaterThan               int
+	CreatedAfter, CreatedBefore time.Time
+	ListEmailID                 int
+	DripProgramActionID         int
+	EmailTemplateID             int
+
+	Placeholder *[]EmailClick
+}
+
+// QueryEmailClicks executes the endpoint with arguments.
+func (p *Pargo) QueryEmailClicks(args QueryEmailClicks) error {
+	body, err := p.do(context.Background(), args)
+	if err != nil {
+		return err
+	}
+	clicks, err := readRecords[EmailClick](body, "emailClick")
+	if err != nil {
+		return err
+	}
+	*args.Placeholder = append(*args.Placeholder, clicks...)
+	return nil
+}
+
+func (QueryEmailClicks) Method() string {
+	return http.MethodGet
+}
+
+func (QueryEmailClicks) Path() string {
+	return "emailClick/" + version + "/do/query"
+}
+
+func (q QueryEmailClicks) Query() (map[string]string, error) {
+	query := map[string]string{
+		"offset": strconv.Itoa(q.Offset),
+		"limit":  strconv.Itoa(q.Limit),
+	}
+	addIDRange(query, q.IDGreaterThan, 0)
+	addTimeRange(query, "created", q.CreatedAfter, q.CreatedBefore)
+	for key, id := range map[string]int{
+		"list_email_id":          q.ListEmailID,
+		"drip_program_action_id": q.DripProgramActionID,
+		"email_template_id":      q.EmailTemplateID,
+	} {
+		if id > 0 {
+			query[key] = strconv.Itoa(id)
+		}
+	}
+	return query, nil
+}
+
+// QueryEmailClicksPager returns a Pager over every email click matching
+// q, by ascending id.
+// The offset of q is ignored and its limit is the page size.
+func (p *Pargo) QueryEmailClicksPager(q QueryEmailClicks) *Pager[EmailClick] {
+	start := q.IDGreaterThan
+	return NewKeysetPager[EmailClick](p, "emailClick", q.Limit,
+		func(after, limit int) Endpoint {
+			q.Offset, q.Limit = 0, limit
+			q.IDGreaterThan = max(after, start)
+			return q
+		},
+		func(c EmailClick) int { return c.ID })
+}
+
+// QueryAllEmailClicks is the set of arguments to page through all email
+// clicks matching Query.
+type QueryAllEmailClicks struct {
+	Query QueryEmailClicks
+
+	// Page is required. It is called serially with each page.
+	Page func([]EmailClick)
+}
+
+// QueryAllEmailClicks calls args.Page with every email click matching
+// args.Query, a page at a time by ascending id.
+func (p *Pargo) QueryAllEmailClicks(args QueryAllEmailClicks) error {
+	pager := p.QueryEmailClicksPager(args.Query)
+	return pageAll(context.Background(), pager, args.Page)
+}
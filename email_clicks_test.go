@@ -0,0 +1,66 @@
+package pargo_test
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/brunoflores/pargo"
+)
+
+func TestQueryAllEmailClicks(t *testing.T) {
+	// Clicks 1 to 300 on the same list email.
+	client := newCannedClient(func(req *http.Request) string {
+		if got := req.URL.Path; got != "/api/emailClick/version/4/do/query" {
+			t.Errorf("got path %q", got)
+		}
+		for k, want := range map[string]string{
+			"list_email_id":     "12",
+			"created_after":     "2020-01-01 00:00:00",
+			"offset":            "0",
+			"email_template_id": "",
+		} {
+			if got := req.FormValue(k); got != want {
+				t.Errorf("%s = %q; want %q", k, got, want)
+			}
+		}
+		after, _ := strconv.Atoi(req.FormValue("id_greater_than"))
+		limit, _ := strconv.Atoi(req.FormValue("limit"))
+		var page []string
+		for id := after + 1; id <= 300 && len(page) < limit; id++ {
+			page = append(page, fmt.Sprintf(
+				`{"id":%d,"prospect_id":%d,"list_email_id":12,"url":"https://x.com"}`,
+				id, id%7))
+		}
+		return `{"result":{"emailClick":[` + strings.Join(page, ",") + `]}}`
+	})
+
+	var pages int
+	last := 50
+	err := client.QueryAllEmailClicks(pargo.QueryAllEmailClicks{
+		Query: pargo.QueryEmailClicks{
+			Limit:         100,
+			IDGreaterThan: 50,
+			CreatedAfter:  time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+			ListEmailID:   12,
+		},
+		Page: func(clicks []pargo.EmailClick) {
+			pages++
+			for _, c := range clicks {
+				if c.ID != last+1 || c.ListEmailID != 12 {
+					t.Fatalf("got click %+v after %d", c, last)
+				}
+				last = c.ID
+			}
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if last != 300 || pages != 3 {
+		t.Fatalf("got %d pages up to %d", pages, last)
+	}
+}
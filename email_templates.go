@@ -0,0 +1,146 @@
+package pargo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+)
+
+// EmailTemplate is an email template, with its bodies as written and as
+// sent with tracked links.
+type EmailTemplate struct {
+	ID                   int              `json:"id"`
+	Name                 string           `json:"name"`
+	Subject              string           `json:"subject"`
+	HTMLMessage          string           `json:"htmlMessage"`
+	TextMessage          string           `json:"textMessage"`
+	TrackedHTMLMessage   string           `json:"trackedHtmlMessage"`
+	TrackedTextMessage   string           `json:"trackedTextMessage"`
+	IsOneToOneEmail      Bool             `json:"isOneToOneEmail"`
+	IsAutoResponderEmail Bool             `json:"isAutoResponderEmail"`
+	IsDripEmail          Bool             `json:"isDripEmail"`
+	IsListEmail          Bool             `json:"isListEmail"`
+	SendOptions          EmailSendOptions `json:"sendOptions"`
+	ReplyToOptions       EmailSendOptions `json:"replyToOptions"`
+	TrackedAssets        []TrackedAsset   `json:"-"`
+	CreatedAt            Time             `json:"created_at"`
+	UpdatedAt            Time             `json:"updated_at"`
+}
+
+// EmailSendOption is a sender or reply to address of a template, such
+// as the assigned user or a general address.
+type EmailSendOption struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+// EmailSendOptions are the send options of a template, which Pardot
+// returns as an object when there is only one.
+type EmailSendOptions []EmailSendOption
+
+func (o *EmailSendOptions) UnmarshalJSON(b []byte) error {
+	options, err := decodeRecords[EmailSendOption](b)
+	if err != nil {
+		return err
+	}
+	*o = options
+	return nil
+}
+
+// TrackedAsset is a tracked asset linked from a template, such as a
+// file or a custom redirect.
+type TrackedAsset struct {
+	ID   int    `json:"id"`
+	Type string `json:"type"`
+	Name string `json:"name"`
+	URL  string `json:"url"`
+}
+
+func (t *EmailTemplate) UnmarshalJSON(b []byte) error {
+	type template EmailTemplate
+	var v struct {
+		template
+		TrackedAssets json.RawMessage `json:"trackedAssets"`
+	}
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	*t = EmailTemplate(v.template)
+	// Assets are nested by kind, such as {"trackedAsset": [...]}.
+	var kinds map[string]json.RawMessage
+	if json.Unmarshal(v.TrackedAssets, &kinds) != nil {
+		return nil
+	}
+	names := make([]string, 0, len(kinds))
+	for name := range kinds {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		assets, err := decodeRecords[TrackedAsset](kinds[name])
+		if err != nil {
+			return err
+		}
+		t.TrackedAssets = append(t.TrackedAssets, assets...)
+	}
+	return nil
+}
+
+// QueryEmailTemplates is an endpoint to list the email templates
+// available for one to one emails.
+type QueryEmailTemplates struct {
+	Placeholder *[]EmailTemplate
+}
+
+// QueryEmailTemplates executes the endpoint with arguments.
+func (p *Pargo) QueryEmailTemplates(args QueryEmailTemplates) error {
+	body, err := p.do(context.Background(), args)
+	if err != nil {
+		return err
+	}
+	var list struct {
+		EmailTemplate json.RawMessage `json:"emailTemplate"`
+	}
+	if err := readRecord(body, "emailTemplates", &list); err != nil {
+		return err
+	}
+	templates, err := decodeRecords[EmailTemplate](list.EmailTemplate)
+	if err != nil {
+		return err
+	}
+	*args.Placeholder = append(*args.Placeholder, templates...)
+	return nil
+}
+
+func (QueryEmailTemplates) Method() string {
+	return http.MethodGet
+}
+
+func (QueryEmailTemplates) Path() string {
+	return "emailTemplate/" + version + "/do/listOneToOne"
+}
+
+// ReadEmailTemplate is an endpoint to read an email template by id.
+type ReadEmailTemplate struct {
+	ID          int
+	Placeholder *EmailTemplate
+}
+
+// ReadEmailTemplate executes the endpoint with arguments.
+func (p *Pargo) ReadEmailTemplate(args ReadEmailTemplate) error {
+	body, err := p.do(context.Background(), args)
+	if err != nil {
+		return err
+	}
+	return readRecord(body, "emailTemplate", args.Placeholder)
+}
+
+func (ReadEmailTemplate) Method() string {
+	return http.MethodGet
+}
+
+func (q ReadEmailTemplate) Path() string {
+	return fmt.Sprintf("emailTemplate/%s/do/read/id/%d", version, q.ID)
+}
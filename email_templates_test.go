@@ -0,0 +1,68 @@
+package pargo_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/brunoflores/pargo"
+)
+
+func TestQueryEmailTemplates(t *testing.T) {
+	for body, want := range map[string]int{
+		`{"emailTemplates":{"emailTemplate":[{"id":1,"name":"A"},{"id":2,"name":"B"}]}}`: 2,
+		`{"emailTemplates":{"emailTemplate":{"id":1,"name":"A"}}}`:                       1,
+		`{"emailTemplates":{}}`: 0,
+	} {
+		var got *http.Request
+		client := newCannedClient(func(req *http.Request) string {
+			got = req
+			return body
+		})
+		var templates []pargo.EmailTemplate
+		err := client.QueryEmailTemplates(pargo.QueryEmailTemplates{Placeholder: &templates})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got.URL.Path != "/api/emailTemplate/version/4/do/listOneToOne" {
+			t.Fatalf("got %s", got.URL.Path)
+		}
+		if len(templates) != want {
+			t.Errorf("%s: got %+v", body, templates)
+		}
+	}
+}
+
+func TestReadEmailTemplate(t *testing.T) {
+	var got *http.Request
+	client := newCannedClient(func(req *http.Request) string {
+		got = req
+		return `{"emailTemplate":{"id":7,"name":"Welcome","subject":"Hi",
+			"htmlMessage":"<p>Hi</p>","textMessage":"Hi",
+			"isOneToOneEmail":"1","isListEmail":false,
+			"sendOptions":{"type":"assigned_user","value":""},
+			"replyToOptions":[{"type":"general_address","value":"a@b.com"},
+				{"type":"assigned_user","value":""}],
+			"trackedAssets":{"trackedAsset":{"id":3,"type":"File","name":"pdf",
+				"url":"https://x.com/f.pdf"}}}}`
+	})
+
+	var template pargo.EmailTemplate
+	err := client.ReadEmailTemplate(pargo.ReadEmailTemplate{ID: 7, Placeholder: &template})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.URL.Path != "/api/emailTemplate/version/4/do/read/id/7" {
+		t.Fatalf("got %s", got.URL.Path)
+	}
+	if template.Name != "Welcome" || template.HTMLMessage != "<p>Hi</p>" ||
+		!template.IsOneToOneEmail || template.IsListEmail {
+		t.Fatalf("got %+v", template)
+	}
+	if len(template.SendOptions) != 1 || template.SendOptions[0].Type != "assigned_user" ||
+		len(template.ReplyToOptions) != 2 {
+		t.Fatalf("got options %+v and %+v", template.SendOptions, template.ReplyToOptions)
+	}
+	if len(template.TrackedAssets) != 1 || template.TrackedAssets[0].ID != 3 {
+		t.Fatalf("got assets %+v", template.TrackedAssets)
+	}
+}
@@ -0,0 +1,294 @@
+package pargo
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Email is an email sent to a prospect or a list.
+type Email struct {
+	ID        int          `json:"id"`
+	Name      string       `json:"name"`
+	Subject   string       `json:"subject"`
+	Message   EmailMessage `json:"message"`
+	CreatedAt Time         `json:"created_at"`
+}
+
+// EmailMessage is the content of an email.
+type EmailMessage struct {
+	Text string `json:"text"`
+	HTML string `json:"html"`
+}
+
+// EmailContent is the content and sender of an email to send.
+// Either TemplateID or the inline Name, Subject and TextContent must be
+// set, and inline content needs a sender: FromEmail with FromName,
+// FromUserID or FromAssignedUser.
+type EmailContent struct {
+	// CampaignID is required.
+	CampaignID int
+
+	TemplateID int
+
+	Name, Subject, TextContent, HTMLContent string
+
+	FromEmail, FromName string
+	FromUserID          int
+
+	// FromAssignedUser sends from the user assigned to the prospect.
+	FromAssignedUser bool
+
+	ReplyToEmail string
+}
+
+func (c EmailContent) validate() error {
+	if c.CampaignID <= 0 {
+		return errors.New("missing campaign id")
+	}
+	inline := c.Name != "" || c.Subject != "" ||
+		c.TextContent != "" || c.HTMLContent != ""
+	if c.TemplateID > 0 {
+		if inline {
+			return errors.New("template id and inline content are exclusive")
+		}
+		return nil
+	}
+	if c.Name == "" || c.Subject == "" || c.TextContent == "" {
+		return errors.New("missing template id or name, subject and text content")
+	}
+	senders := 0
+	if c.FromEmail != "" || c.FromName != "" {
+		if c.FromEmail == "" || c.FromName == "" {
+			return errors.New("from email and from name go together")
+		}
+		senders++
+	}
+	if c.FromUserID > 0 {
+		senders++
+	}
+	if c.FromAssignedUser {
+		senders++
+	}
+	if senders != 1 {
+		return errors.New("inline content needs exactly one sender")
+	}
+	return nil
+}
+
+// query returns the ids of the content, which are short enough for the
+// query string.
+func (c EmailContent) query() map[string]string {
+	query := map[string]string{"campaign_id": strconv.Itoa(c.CampaignID)}
+	if c.TemplateID > 0 {
+		query["email_template_id"] = strconv.Itoa(c.TemplateID)
+	}
+	if c.FromUserID > 0 {
+		query["from_user_id"] = strconv.Itoa(c.FromUserID)
+	}
+	return query
+}
+
+// form returns the rest of the content, sent as a form-encoded body
+// since HTML bodies easily exceed the length limits of URLs.
+func (c EmailContent) form() url.Values {
+	form := make(url.Values)
+	for k, v := range map[string]string{
+		"name":          c.Name,
+		"subject":       c.Subject,
+		"text_content":  c.TextContent,
+		"html_content":  c.HTMLContent,
+		"from_email":    c.FromEmail,
+		"from_name":     c.FromName,
+		"replyto_email": c.ReplyToEmail,
+	} {
+		if v != "" {
+			form.Set(k, v)
+		}
+	}
+	if c.FromAssignedUser {
+		form.Set("from_assigned_user", "1")
+	}
+	return form
+}
+
+// SendProspectEmail is an endpoint to send a one to one email to a
+// prospect, given by ProspectID or ProspectEmail.
+// The optional Placeholder receives the email sent.
+type SendProspectEmail struct {
+	ProspectID    int
+	ProspectEmail string
+	EmailContent
+	Placeholder *Email
+}
+
+// SendProspectEmail executes the endpoint with arguments.
+// Arguments are validated before anything is sent.
+func (p *Pargo) SendProspectEmail(args SendProspectEmail) error {
+	if args.ProspectID <= 0 && args.ProspectEmail == "" {
+		return errors.New("missing prospect id or email")
+	}
+	if err := args.validate(); err != nil {
+		return err
+	}
+	body, err := p.do(context.Background(), args)
+	if err != nil {
+		return err
+	}
+	return readRecord(body, "email", args.Placeholder)
+}
+
+func (SendProspectEmail) Method() string {
+	return http.MethodPost
+}
+
+func (q SendProspectEmail) Path() string {
+	if q.ProspectID > 0 {
+		return fmt.Sprintf("email/%s/do/send/prospect_id/%d", version, q.ProspectID)
+	}
+	return fmt.Sprintf("email/%s/do/send/prospect_email/%s", version, q.ProspectEmail)
+}
+
+func (q SendProspectEmail) Query() (map[string]string, error) {
+	return q.query(), nil
+}
+
+func (q SendProspectEmail) Body() (io.ReadCloser, error) {
+	return ioutil.NopCloser(strings.NewReader(q.form().Encode())), nil
+}
+
+// SendListEmail is an endpoint to send an email to the prospects of
+// lists, now or at ScheduledTime.
+// The optional Placeholder receives the email sent.
+type SendListEmail struct {
+	// ListIDs is required.
+	ListIDs []int
+
+	// SuppressionListIDs are lists whose prospects are not sent to.
+	SuppressionListIDs []int
+
+	// ScheduledTime is in the timezone of the account. The zero value
+	// sends now.
+	ScheduledTime time.Time
+
+	EmailContent
+	Placeholder *Email
+}
+
+// SendListEmail executes the endpoint with arguments.
+// Arguments are validated before anything is sent.
+func (p *Pargo) SendListEmail(args SendListEmail) error {
+	if len(args.ListIDs) == 0 {
+		return errors.New("missing list ids")
+	}
+	if args.FromAssignedUser {
+		return errors.New("list emails cannot be sent from the assigned user")
+	}
+	if err := args.validate(); err != nil {
+		return err
+	}
+	body, err := p.do(context.Background(), args)
+	if err != nil {
+		return err
+	}
+	return readRecord(body, "email", args.Placeholder)
+}
+
+func (SendListEmail) Method() string {
+	return http.MethodPost
+}
+
+func (SendListEmail) Path() string {
+	return "email/" + version + "/do/send"
+}
+
+func (q SendListEmail) Query() (map[string]string, error) {
+	query := q.query()
+	addIntArray(query, "list_ids", q.ListIDs)
+	addIntArray(query, "suppression_list_ids", q.SuppressionListIDs)
+	return query, nil
+}
+
+func (q SendListEmail) Body() (io.ReadCloser, error) {
+	form := q.form()
+	if !q.ScheduledTime.IsZero() {
+		form.Set("scheduled_time", q.ScheduledTime.Format(timeLayout))
+	}
+	return ioutil.NopCloser(strings.NewReader(form.Encode())), nil
+}
+
+// ReadEmail is an endpoint to read a sent email by id.
+type ReadEmail struct {
+	ID          int
+	Placeholder *Email
+}
+
+// ReadEmail executes the endpoint with arguments.
+func (p *Pargo) ReadEmail(args ReadEmail) error {
+	body, err := p.do(context.Background(), args)
+	if err != nil {
+		return err
+	}
+	return readRecord(body, "email", args.Placeholder)
+}
+
+func (ReadEmail) Method() string {
+	return http.MethodGet
+}
+
+func (q ReadEmail) Path() string {
+	return fmt.Sprintf("email/%s/do/read/id/%d", version, q.ID)
+}
+
+// EmailStats are the delivery and engagement numbers of a list email.
+// Rates are percentages, such as 12.5 for 12.5%.
+type EmailStats struct {
+	Sent                   Int   `json:"sent"`
+	Delivered              Int   `json:"delivered"`
+	TotalClicks            Int   `json:"total_clicks"`
+	UniqueClicks           Int   `json:"unique_clicks"`
+	Opens                  Int   `json:"opens"`
+	UniqueOpens            Int   `json:"unique_opens"`
+	SoftBounced            Int   `json:"soft_bounced"`
+	HardBounced            Int   `json:"hard_bounced"`
+	OptOuts                Int   `json:"opt_outs"`
+	SpamComplaints         Int   `json:"spam_complaints"`
+	DeliveryRate           Float `json:"delivery_rate"`
+	OpensRate              Float `json:"opens_rate"`
+	ClickThroughRate       Float `json:"click_through_rate"`
+	UniqueClickThroughRate Float `json:"unique_click_through_rate"`
+	ClickOpenRatio         Float `json:"click_open_ratio"`
+	OptOutRate             Float `json:"opt_out_rate"`
+	SpamComplaintRate      Float `json:"spam_complaint_rate"`
+}
+
+// ReadEmailStats is an endpoint to read the stats of a list email by id.
+type ReadEmailStats struct {
+	ID          int
+	Placeholder *EmailStats
+}
+
+// ReadEmailStats executes the endpoint with arguments.
+func (p *Pargo) ReadEmailStats(args ReadEmailStats) error {
+	body, err := p.do(context.Background(), args)
+	if err != nil {
+		return err
+	}
+	return readRecord(body, "stats", args.Placeholder)
+}
+
+func (ReadEmailStats) Method() string {
+	return http.MethodGet
+}
+
+func (q ReadEmailStats) Path() string {
+	return fmt.Sprintf("email/%s/do/stats/id/%d", version, q.ID)
+}
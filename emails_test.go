@@ -0,0 +1,232 @@
+package pargo_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/brunoflores/pargo"
+)
+
+// emailRequest is a request to send an email, with its query and its
+// form-encoded body.
+type emailRequest struct {
+	*http.Request
+	query, form map[string]string
+}
+
+// newEmailClient returns a client answering with body and recording the
+// last request in *got.
+func newEmailClient(t *testing.T, got *emailRequest, body string) *pargo.Pargo {
+	flatten := func(values url.Values) map[string]string {
+		m := make(map[string]string)
+		for k := range values {
+			if k != "format" {
+				m[k] = values.Get(k)
+			}
+		}
+		return m
+	}
+	return newCannedClient(func(req *http.Request) string {
+		b, err := ioutil.ReadAll(req.Body)
+		if err != nil {
+			t.Error(err)
+		}
+		form, err := url.ParseQuery(string(b))
+		if err != nil {
+			t.Error(err)
+		}
+		*got = emailRequest{req, flatten(req.URL.Query()), flatten(form)}
+		return body
+	})
+}
+
+func TestSendProspectEmail(t *testing.T) {
+	var got emailRequest
+	client := newEmailClient(t, &got, `{"email":{"id":12,"name":"Welcome",
+		"subject":"Hi","message":{"text":"Hello","html":"<p>Hello</p>"}}}`)
+
+	html := "<p>" + strings.Repeat("Hello ", 2000) + "</p>"
+	var email pargo.Email
+	err := client.SendProspectEmail(pargo.SendProspectEmail{
+		ProspectEmail: "a@b.com",
+		EmailContent: pargo.EmailContent{
+			CampaignID:  4,
+			Name:        "Welcome",
+			Subject:     "Hi",
+			TextContent: "Hello",
+			HTMLContent: html,
+			FromUserID:  3,
+		},
+		Placeholder: &email,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Method != "POST" ||
+		got.URL.Path != "/api/email/version/4/do/send/prospect_email/a@b.com" {
+		t.Fatalf("got %s %s", got.Method, got.URL.Path)
+	}
+	if want := map[string]string{"campaign_id": "4", "from_user_id": "3"}; !reflect.DeepEqual(got.query, want) {
+		t.Errorf("got query %v; want %v", got.query, want)
+	}
+	want := map[string]string{
+		"name": "Welcome", "subject": "Hi", "text_content": "Hello",
+		"html_content": html,
+	}
+	if !reflect.DeepEqual(got.form, want) {
+		t.Errorf("got body %v; want %v", got.form, want)
+	}
+	if email.ID != 12 || email.Message.HTML != "<p>Hello</p>" {
+		t.Fatalf("got %+v", email)
+	}
+}
+
+func TestSendListEmail(t *testing.T) {
+	var got emailRequest
+	client := newEmailClient(t, &got, `{"email":{"id":13}}`)
+
+	err := client.SendListEmail(pargo.SendListEmail{
+		ListIDs:            []int{1, 2},
+		SuppressionListIDs: []int{9},
+		ScheduledTime:      time.Date(2020, 5, 1, 9, 30, 0, 0, time.UTC),
+		EmailContent:       pargo.EmailContent{CampaignID: 4, TemplateID: 7},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.URL.Path != "/api/email/version/4/do/send" {
+		t.Fatalf("got %s", got.URL.Path)
+	}
+	want := map[string]string{
+		"list_ids[0]":             "1",
+		"list_ids[1]":             "2",
+		"suppression_list_ids[0]": "9",
+		"campaign_id":             "4",
+		"email_template_id":       "7",
+	}
+	if !reflect.DeepEqual(got.query, want) {
+		t.Errorf("got query %v; want %v", got.query, want)
+	}
+	want = map[string]string{"scheduled_time": "2020-05-01 09:30:00"}
+	if !reflect.DeepEqual(got.form, want) {
+		t.Errorf("got body %v; want %v", got.form, want)
+	}
+}
+
+func TestSendEmailAfterExpiredKey(t *testing.T) {
+	// The body must be sent again once the api key is refreshed.
+	var bodies []string
+	client := newCannedClient(func(req *http.Request) string {
+		b, err := ioutil.ReadAll(req.Body)
+		if err != nil {
+			t.Error(err)
+		}
+		bodies = append(bodies, string(b))
+		if len(bodies) == 1 {
+			return `{"@attributes":{"err_code":1},"err":"Invalid API key"}`
+		}
+		return `{"email":{"id":14}}`
+	})
+
+	err := client.SendProspectEmail(pargo.SendProspectEmail{
+		ProspectID: 5,
+		EmailContent: pargo.EmailContent{
+			CampaignID: 4, Name: "n", Subject: "s", TextContent: "t",
+			FromAssignedUser: true,
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(bodies) != 2 || bodies[1] == "" || bodies[0] != bodies[1] {
+		t.Fatalf("got bodies %q", bodies)
+	}
+}
+
+func TestSendEmailValidation(t *testing.T) {
+	client := newCannedClient(func(req *http.Request) string {
+		t.Errorf("unexpected request to %s", req.URL.Path)
+		return `{}`
+	})
+
+	inline := pargo.EmailContent{
+		CampaignID: 4, Name: "n", Subject: "s", TextContent: "t"}
+	tests := []struct {
+		name string
+		call func() error
+	}{
+		{"no prospect", func() error {
+			return client.SendProspectEmail(pargo.SendProspectEmail{
+				EmailContent: pargo.EmailContent{CampaignID: 4, TemplateID: 7}})
+		}},
+		{"no campaign", func() error {
+			return client.SendProspectEmail(pargo.SendProspectEmail{
+				ProspectID: 1, EmailContent: pargo.EmailContent{TemplateID: 7}})
+		}},
+		{"no content", func() error {
+			return client.SendProspectEmail(pargo.SendProspectEmail{
+				ProspectID: 1, EmailContent: pargo.EmailContent{CampaignID: 4}})
+		}},
+		{"template and content", func() error {
+			c := inline
+			c.TemplateID = 7
+			return client.SendProspectEmail(pargo.SendProspectEmail{
+				ProspectID: 1, EmailContent: c})
+		}},
+		{"no sender", func() error {
+			return client.SendProspectEmail(pargo.SendProspectEmail{
+				ProspectID: 1, EmailContent: inline})
+		}},
+		{"from email without name", func() error {
+			c := inline
+			c.FromEmail = "me@b.com"
+			return client.SendProspectEmail(pargo.SendProspectEmail{
+				ProspectID: 1, EmailContent: c})
+		}},
+		{"no lists", func() error {
+			return client.SendListEmail(pargo.SendListEmail{
+				EmailContent: pargo.EmailContent{CampaignID: 4, TemplateID: 7}})
+		}},
+		{"list from assigned user", func() error {
+			c := inline
+			c.FromAssignedUser = true
+			return client.SendListEmail(pargo.SendListEmail{
+				ListIDs: []int{1}, EmailContent: c})
+		}},
+	}
+	for _, test := range tests {
+		if err := test.call(); err == nil {
+			t.Errorf("%s: expected an error", test.name)
+		}
+	}
+}
+
+func TestReadEmailStats(t *testing.T) {
+	var got *http.Request
+	client := newCannedClient(func(req *http.Request) string {
+		got = req
+		return `{"stats":{"sent":"1,200","delivered":1150,"unique_clicks":"40",
+			"hard_bounced":null,"opt_outs":"","delivery_rate":"95.83%",
+			"click_through_rate":3.5}}`
+	})
+
+	var stats pargo.EmailStats
+	if err := client.ReadEmailStats(pargo.ReadEmailStats{ID: 13, Placeholder: &stats}); err != nil {
+		t.Fatal(err)
+	}
+	if got.URL.Path != "/api/email/version/4/do/stats/id/13" {
+		t.Fatalf("got %s", got.URL.Path)
+	}
+	want := pargo.EmailStats{
+		Sent: 1200, Delivered: 1150, UniqueClicks: 40,
+		DeliveryRate: 95.83, ClickThroughRate: 3.5,
+	}
+	if stats != want {
+		t.Fatalf("got %+v; want %+v", stats, want)
+	}
+}
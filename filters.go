@@ -0,0 +1,102 @@
+package pargo
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// QueryFilters are the filters and sort order shared by most query
+// endpoints. Zero values are not sent to Pardot.
+type QueryFilters struct {
+	IDGreaterThan, IDLessThan   int
+	CreatedAfter, CreatedBefore time.Time
+	UpdatedAfter, UpdatedBefore time.Time
+
+	// SortBy is a field such as "id" or "created_at", and SortOrder
+	// either "ascending" or "descending".
+	SortBy, SortOrder string
+}
+
+func (f QueryFilters) add(query map[string]string) {
+	addIDRange(query, f.IDGreaterThan, f.IDLessThan)
+	addTimeRange(query, "created", f.CreatedAfter, f.CreatedBefore)
+	addTimeRange(query, "updated", f.UpdatedAfter, f.UpdatedBefore)
+	if f.SortBy != "" {
+		query["sort_by"] = f.SortBy
+	}
+	if f.SortOrder != "" {
+		query["sort_order"] = f.SortOrder
+	}
+}
+
+// pageQuery returns the offset and limit of a page, with the filters.
+func (f QueryFilters) pageQuery(offset, limit int) map[string]string {
+	query := map[string]string{
+		"offset": strconv.Itoa(offset),
+		"limit":  strconv.Itoa(limit),
+	}
+	f.add(query)
+	return query
+}
+
+// addBool adds a boolean parameter, if set.
+func addBool(query map[string]string, key string, v *bool) {
+	if v != nil {
+		query[key] = strconv.FormatBool(*v)
+	}
+}
+
+// addString adds a parameter, if not empty.
+func addString(query map[string]string, key, v string) {
+	if v != "" {
+		query[key] = v
+	}
+}
+
+// addIDRange adds the id_greater_than and id_less_than filters, if set.
+func addIDRange(query map[string]string, greaterThan, lessThan int) {
+	if greaterThan > 0 {
+		query["id_greater_than"] = strconv.Itoa(greaterThan)
+	}
+	if lessThan > 0 {
+		query["id_less_than"] = strconv.Itoa(lessThan)
+	}
+}
+
+// addTimeRange adds the <prefix>_after and <prefix>_before filters, if
+// set, such as created_after.
+func addTimeRange(query map[string]string, prefix string, after, before time.Time) {
+	if !after.IsZero() {
+		query[prefix+"_after"] = after.Format(timeLayout)
+	}
+	if !before.IsZero() {
+		query[prefix+"_before"] = before.Format(timeLayout)
+	}
+}
+
+// addInts adds a comma separated list of ids, if any.
+func addInts(query map[string]string, key string, ids []int) {
+	if len(ids) == 0 {
+		return
+	}
+	strs := make([]string, len(ids))
+	for i, id := range ids {
+		strs[i] = strconv.Itoa(id)
+	}
+	query[key] = strings.Join(strs, ",")
+}
+
+// formatFloat formats a number without trailing zeros.
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+// addIntArray adds ids as an array parameter, if any, with indexed keys
+// such as list_ids[0] since a query holds one value per key.
+func addIntArray(query map[string]string, key string, ids []int) {
+	for i, id := range ids {
+		query[fmt.Sprintf("%s[%d]", key, i)] = strconv.Itoa(id)
+	}
+}
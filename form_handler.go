@@ -0,0 +1,146 @@
+package pargo
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// visitorCookiePrefix starts the names of the cookies Pardot uses to
+// track visitors, such as visitor_id123 and visitor_id123-hash.
+const visitorCookiePrefix = "visitor_id"
+
+// FormHandlerClient submits data to Pardot form handlers, which are
+// public URLs outside of the authenticated API.
+//
+// Pardot answers a submission with a redirect to the success or the
+// error location configured on the form handler. Setting the same
+// locations on the client tells them apart; otherwise a redirect with
+// an errorMessage or errors parameter is taken as an error.
+type FormHandlerClient struct {
+	client     *http.Client
+	successURL string
+	errorURL   string
+}
+
+// NewFormHandlerClient returns a client for form handlers redirecting to
+// successURL or errorURL, either of which may be empty.
+func NewFormHandlerClient(
+	successURL, errorURL string,
+	confs ...func(*FormHandlerClient),
+) *FormHandlerClient {
+	c := FormHandlerClient{
+		client:     &http.Client{},
+		successURL: successURL,
+		errorURL:   errorURL,
+	}
+	for _, conf := range confs {
+		conf(&c)
+	}
+	return &c
+}
+
+// WithFormHandlerHTTPClient sets a custom http.Client. Redirects are
+// never followed, whatever its CheckRedirect.
+func WithFormHandlerHTTPClient(hc *http.Client) func(*FormHandlerClient) {
+	return func(c *FormHandlerClient) {
+		c.client = hc
+	}
+}
+
+// FormSubmission is a submission to a form handler.
+type FormSubmission struct {
+	// URL of the form handler.
+	URL string
+
+	// Fields are posted url-encoded.
+	Fields map[string]string
+
+	// Cookies of the visitor submitting the form, as received by the
+	// site. Only the Pardot visitor cookies are passed on.
+	Cookies []*http.Cookie
+}
+
+// FormResult is how a form handler answered a submission.
+type FormResult struct {
+	// Success tells whether Pardot redirected to the success location.
+	Success bool
+
+	// Location is where Pardot redirected to.
+	Location string
+
+	// ErrorMessage is the message Pardot gave with an error, if any.
+	ErrorMessage string
+
+	// Cookies are the Pardot visitor cookies set by the response, to be
+	// passed back to the visitor.
+	Cookies []*http.Cookie
+}
+
+// Submit posts a submission to a form handler and reports whether it
+// succeeded. Errors are only returned when Pardot could not be reached
+// or did not redirect.
+func (c *FormHandlerClient) Submit(ctx context.Context, s FormSubmission) (FormResult, error) {
+	form := make(url.Values)
+	for k, v := range s.Fields {
+		form.Set(k, v)
+	}
+	req, err := http.NewRequest(http.MethodPost, s.URL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return FormResult{}, errors.Wrap(err, "building request")
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	for _, cookie := range visitorCookies(s.Cookies) {
+		req.AddCookie(cookie)
+	}
+
+	hc := *c.client
+	hc.CheckRedirect = func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	}
+	res, err := hc.Do(req.WithContext(ctx))
+	if err != nil {
+		return FormResult{}, errors.Wrap(err, "issuing request")
+	}
+	defer res.Body.Close()
+	io.Copy(ioutil.Discard, res.Body)
+
+	location := res.Header.Get("Location")
+	if res.StatusCode < 300 || res.StatusCode >= 400 || location == "" {
+		return FormResult{}, errors.Errorf(
+			"form handler answered %d without a redirect", res.StatusCode)
+	}
+	result := FormResult{
+		Location: location,
+		Cookies:  visitorCookies(res.Cookies()),
+	}
+	u, err := url.Parse(location)
+	if err != nil {
+		return FormResult{}, errors.Wrap(err, "parsing redirect")
+	}
+	result.ErrorMessage = u.Query().Get("errorMessage")
+	switch {
+	case c.errorURL != "" && strings.HasPrefix(location, c.errorURL):
+	case c.successURL != "" && strings.HasPrefix(location, c.successURL):
+		result.Success = true
+	default:
+		result.Success = result.ErrorMessage == "" && u.Query().Get("errors") == ""
+	}
+	return result, nil
+}
+
+// visitorCookies returns the Pardot visitor cookies among cookies.
+func visitorCookies(cookies []*http.Cookie) []*http.Cookie {
+	var visitor []*http.Cookie
+	for _, cookie := range cookies {
+		if strings.HasPrefix(cookie.Name, visitorCookiePrefix) {
+			visitor = append(visitor, cookie)
+		}
+	}
+	return visitor
+}
@@ -0,0 +1,89 @@
+package pargo_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/brunoflores/pargo"
+)
+
+func TestFormHandlerSubmit(t *testing.T) {
+	const (
+		success = "https://example.com/thanks"
+		failure = "https://example.com/oops"
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("got method %s", r.Method)
+		}
+		if c, err := r.Cookie("visitor_id12"); err != nil || c.Value != "v1" {
+			t.Errorf("visitor cookie = %v, %v", c, err)
+		}
+		if _, err := r.Cookie("session"); err == nil {
+			t.Error("site cookie passed on to Pardot")
+		}
+		http.SetCookie(w, &http.Cookie{Name: "visitor_id12-hash", Value: "h"})
+		http.SetCookie(w, &http.Cookie{Name: "pardot", Value: "p"})
+		switch r.URL.Path {
+		case "/ok":
+			http.Redirect(w, r, success+"?from=pardot", http.StatusFound)
+		case "/bad":
+			http.Redirect(w, r, failure+"?errorMessage=Email+is+invalid", http.StatusFound)
+		case "/default":
+			if r.FormValue("email") == "" {
+				http.Redirect(w, r, "https://example.com/form?errors=true", http.StatusFound)
+				return
+			}
+			http.Redirect(w, r, "https://example.com/form", http.StatusFound)
+		default:
+			w.Write([]byte("Thank you"))
+		}
+	}))
+	defer srv.Close()
+
+	client := pargo.NewFormHandlerClient(success, failure,
+		pargo.WithFormHandlerHTTPClient(srv.Client()))
+	cookies := []*http.Cookie{
+		{Name: "visitor_id12", Value: "v1"},
+		{Name: "session", Value: "s"},
+	}
+	submit := func(path string, fields map[string]string) pargo.FormResult {
+		t.Helper()
+		result, err := client.Submit(context.Background(), pargo.FormSubmission{
+			URL: srv.URL + path, Fields: fields, Cookies: cookies})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return result
+	}
+
+	result := submit("/ok", map[string]string{"email": "a@b.com"})
+	if !result.Success || result.Location != success+"?from=pardot" {
+		t.Errorf("got %+v", result)
+	}
+	if len(result.Cookies) != 1 || result.Cookies[0].Name != "visitor_id12-hash" {
+		t.Errorf("got cookies %v", result.Cookies)
+	}
+
+	result = submit("/bad", map[string]string{"email": "nope"})
+	if result.Success || result.ErrorMessage != "Email is invalid" {
+		t.Errorf("got %+v", result)
+	}
+
+	client = pargo.NewFormHandlerClient("", "",
+		pargo.WithFormHandlerHTTPClient(srv.Client()))
+	if result := submit("/default", nil); result.Success {
+		t.Errorf("got %+v", result)
+	}
+	if result := submit("/default", map[string]string{"email": "a@b.com"}); !result.Success {
+		t.Errorf("got %+v", result)
+	}
+
+	_, err := client.Submit(context.Background(), pargo.FormSubmission{
+		URL: srv.URL + "/page", Cookies: cookies})
+	if err == nil {
+		t.Fatal("expected an error without a redirect")
+	}
+}
@@ -1,3 +1,5 @@
 module github.com/brunoflores/pargo
 
+go 1.23
+
 require github.com/pkg/errors v0.8.1
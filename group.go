@@ -0,0 +1,76 @@
+package pargo
+
+import "sync"
+
+// group is a collection of goroutines working on subtasks of the same
+// task, in the fashion of golang.org/x/sync/errgroup.
+// The group is stopped by the first subtask to fail, or by calling stop
+// once the task is complete, so the others can wind down.
+type group struct {
+	wg       sync.WaitGroup
+	stopOnce sync.Once
+	quit     chan struct{}
+
+	errMu sync.Mutex
+	err   error
+}
+
+func newGroup() *group {
+	return &group{quit: make(chan struct{})}
+}
+
+// Go calls fn in a new goroutine.
+// The first non-nil error returned is recorded and stops the group.
+func (g *group) Go(fn func() error) {
+	g.wg.Add(1)
+	go func() {
+		defer g.wg.Done()
+		if err := fn(); err != nil {
+			g.errMu.Lock()
+			if g.err == nil {
+				g.err = err
+			}
+			g.errMu.Unlock()
+			g.stop()
+		}
+	}()
+}
+
+// stop closes the channel returned by done, telling the goroutines no
+// more work is needed. It does not record an error, so one returned
+// later by a goroutine still in flight is reported by Wait.
+// It is safe to call stop several times.
+func (g *group) stop() {
+	g.stopOnce.Do(func() { close(g.quit) })
+}
+
+// done returns a channel closed once the group has been stopped.
+func (g *group) done() <-chan struct{} {
+	return g.quit
+}
+
+// stopped tells whether the group has been stopped.
+func (g *group) stopped() bool {
+	select {
+	case <-g.quit:
+		return true
+	default:
+		return false
+	}
+}
+
+// failed tells whether a goroutine has returned an error.
+func (g *group) failed() bool {
+	g.errMu.Lock()
+	defer g.errMu.Unlock()
+	return g.err != nil
+}
+
+// Wait blocks until all goroutines have returned, then returns the
+// first non-nil error, if any.
+func (g *group) Wait() error {
+	g.wg.Wait()
+	g.errMu.Lock()
+	defer g.errMu.Unlock()
+	return g.err
+}
@@ -1,7 +1,17 @@
 package pargo_test
 
 import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
 	"net/http"
+	"reflect"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
 
 	"github.com/brunoflores/pargo"
 )
@@ -54,3 +64,185 @@ type roundTripFunc func(req *http.Request) *http.Response
 func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
 	return f(req), nil
 }
+
+// testProspect is a prospect served by newProspectsServer.
+type testProspect struct {
+	ID        int    `json:"id"`
+	UpdatedAt string `json:"updated_at,omitempty"`
+}
+
+func testProspects(ids []int) *[]testProspect {
+	prospects := make([]testProspect, 0, len(ids))
+	for _, id := range ids {
+		prospects = append(prospects, testProspect{ID: id})
+	}
+	return &prospects
+}
+
+// newProspectsServer serves the prospects, honouring the offset, limit,
+// id and updated_at filters and the sort orders of the prospect query.
+// Ties when sorting by updated_at are deliberately left in the given
+// order. Check, if not nil, is called with every query.
+// Failures are reported with t.Errorf as the server may be called from
+// several goroutines.
+func newProspectsServer(
+	t *testing.T,
+	prospects *[]testProspect,
+	check func(*http.Request),
+) *http.Client {
+	const layout = "2006-01-02 15:04:05"
+	return newTestHTTPClient(func(req *http.Request) *http.Response {
+		u := req.URL.Path
+		switch {
+		case strings.Contains(u, `oauth2/`):
+			return &http.Response{
+				StatusCode: 200,
+				Body:       ioutil.NopCloser(bytes.NewBufferString(`{}`)),
+				Header:     make(http.Header)}
+		case strings.Contains(u, `/query`):
+		default:
+			t.Errorf("unknown endpoint called %q", u)
+			return &http.Response{
+				StatusCode: 404,
+				Body:       ioutil.NopCloser(bytes.NewBufferString(`{}`)),
+				Header:     make(http.Header)}
+		}
+		if check != nil {
+			check(req)
+		}
+		atoi := func(k string) int {
+			n, _ := strconv.Atoi(req.FormValue(k))
+			return n
+		}
+		after, _ := time.Parse(layout, req.FormValue("updated_after"))
+		before, _ := time.Parse(layout, req.FormValue("updated_before"))
+		var page []testProspect
+		for _, p := range *prospects {
+			at, _ := time.Parse(layout, p.UpdatedAt)
+			switch {
+			case p.ID <= atoi("id_greater_than"):
+			case atoi("id_less_than") > 0 && p.ID >= atoi("id_less_than"):
+			case !after.IsZero() && !at.After(after):
+			case !before.IsZero() && !at.Before(before):
+			default:
+				page = append(page, p)
+			}
+		}
+		switch req.FormValue("sort_by") {
+		case "", "id":
+			sort.Slice(page, func(i, j int) bool {
+				return page[i].ID < page[j].ID
+			})
+		case "updated_at":
+			sort.SliceStable(page, func(i, j int) bool {
+				return page[i].UpdatedAt < page[j].UpdatedAt
+			})
+		default:
+			t.Errorf("unexpected sort_by %q", req.FormValue("sort_by"))
+		}
+		if req.FormValue("sort_order") == "descending" {
+			for i, j := 0, len(page)-1; i < j; i, j = i+1, j-1 {
+				page[i], page[j] = page[j], page[i]
+			}
+		}
+		if offset := atoi("offset"); offset < len(page) {
+			page = page[offset:]
+		} else {
+			page = nil
+		}
+		if limit := atoi("limit"); len(page) > limit {
+			page = page[:limit]
+		}
+		var bodyStr string
+		switch len(page) {
+		case 0:
+			bodyStr = `{"result":{"total_results":0}}`
+		case 1:
+			b, _ := json.Marshal(page[0])
+			bodyStr = `{"result":{"prospect":` + string(b) + `}}`
+		default:
+			b, _ := json.Marshal(page)
+			bodyStr = `{"result":{"prospect":` + string(b) + `}}`
+		}
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(bodyStr)),
+			Header:     make(http.Header)}
+	})
+}
+
+// checkNoLeaks returns a function to be deferred by a test, failing it if
+// goroutines started since checkNoLeaks was called are still running.
+func checkNoLeaks(t *testing.T) func() {
+	before := runtime.NumGoroutine()
+	return func() {
+		deadline := time.Now().Add(time.Second)
+		for {
+			n := runtime.NumGoroutine()
+			if n <= before {
+				return
+			}
+			if time.Now().After(deadline) {
+				t.Fatalf("%d goroutines leaked", n-before)
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+	}
+}
+
+// newCannedClient returns a client logging in successfully and answering
+// every other request with the body returned by respond.
+func newCannedClient(respond func(req *http.Request) string) *pargo.Pargo {
+	return newTestClient(newTestHTTPClient(func(req *http.Request) *http.Response {
+		body := `{}`
+		if !strings.Contains(req.URL.Path, `oauth2/`) {
+			body = respond(req)
+		}
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+			Header:     make(http.Header)}
+	}))
+}
+
+// endpointCall is a call to an endpoint and the request it must issue.
+// Query is the exact set of query parameters, besides the format.
+type endpointCall struct {
+	call         func() error
+	method, path string
+	query        map[string]string
+}
+
+// checkEndpointCalls makes each call in turn and checks the request it
+// issued, which the test client must record in *got.
+func checkEndpointCalls(t *testing.T, got **http.Request, calls []endpointCall) {
+	t.Helper()
+	for _, c := range calls {
+		*got = nil
+		if err := c.call(); err != nil {
+			t.Fatalf("%s: %v", c.path, err)
+		}
+		req := *got
+		if req == nil {
+			t.Fatalf("%s: no request issued", c.path)
+		}
+		if req.Method != c.method || req.URL.Path != c.path {
+			t.Errorf("got %s %s; want %s %s",
+				req.Method, req.URL.Path, c.method, c.path)
+			continue
+		}
+		query := make(map[string]string)
+		for k := range req.URL.Query() {
+			if k != "format" {
+				query[k] = req.URL.Query().Get(k)
+			}
+		}
+		want := c.query
+		if want == nil {
+			want = map[string]string{}
+		}
+		if !reflect.DeepEqual(query, want) {
+			t.Errorf("%s: got query %v; want %v", c.path, query, want)
+		}
+	}
+}
@@ -0,0 +1,212 @@
+package pargo
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"time"
+)
+
+// LifecycleStage is a stage of the funnel, such as "MQL".
+type LifecycleStage struct {
+	ID        int    `json:"id"`
+	Name      string `json:"name"`
+	Position  int    `json:"position"`
+	IsLocked  Bool   `json:"is_locked"`
+	CreatedAt Time   `json:"created_at"`
+	UpdatedAt Time   `json:"updated_at"`
+}
+
+// LifecycleHistory is the move of a prospect from a stage to the next.
+// PreviousStageID is zero when the prospect entered the funnel.
+type LifecycleHistory struct {
+	ID              int  `json:"id"`
+	ProspectID      int  `json:"prospect_id"`
+	PreviousStageID int  `json:"previous_stage_id"`
+	NextStageID     int  `json:"next_stage_id"`
+	SecondsElapsed  int  `json:"seconds_elapsed"`
+	CreatedAt       Time `json:"created_at"`
+}
+
+// QueryLifecycleStages is an endpoint to query a page of lifecycle
+// stages.
+type QueryLifecycleStages struct {
+	Offset, Limit int
+	QueryFilters
+
+	Placeholder *[]LifecycleStage
+}
+
+// QueryLifecycleStages executes the endpoint with arguments.
+func (p *Pargo) QueryLifecycleStages(args QueryLifecycleStages) error {
+	body, err := p.do(context.Background(), args)
+	if err != nil {
+		return err
+	}
+	stages, err := readRecords[LifecycleStage](body, "lifecycleStage")
+	if err != nil {
+		return err
+	}
+	*args.Placeholder = append(*args.Placeholder, stages...)
+	return nil
+}
+
+func (QueryLifecycleStages) Method() string {
+	return http.MethodGet
+}
+
+func (QueryLifecycleStages) Path() string {
+	return "lifecycleStage/" + version + "/do/query"
+}
+
+func (q QueryLifecycleStages) Query() (map[string]string, error) {
+	return q.pageQuery(q.Offset, q.Limit), nil
+}
+
+// QueryLifecycleStagesPager returns a Pager over every lifecycle stage
+// matching q. The offset of q is ignored and its limit is the page size.
+func (p *Pargo) QueryLifecycleStagesPager(q QueryLifecycleStages) *Pager[LifecycleStage] {
+	return NewPager[LifecycleStage](p, "lifecycleStage", q.Limit,
+		func(offset, limit int) Endpoint {
+			q.Offset, q.Limit = offset, limit
+			return q
+		})
+}
+
+// QueryLifecycleHistories is an endpoint to query a page of lifecycle
+// histories. Only the id and created filters apply.
+type QueryLifecycleHistories struct {
+	Offset, Limit int
+	QueryFilters
+
+	Placeholder *[]LifecycleHistory
+}
+
+// QueryLifecycleHistories executes the endpoint with arguments.
+func (p *Pargo) QueryLifecycleHistories(args QueryLifecycleHistories) error {
+	body, err := p.do(context.Background(), args)
+	if err != nil {
+		return err
+	}
+	histories, err := readRecords[LifecycleHistory](body, "lifecycleHistory")
+	if err != nil {
+		return err
+	}
+	*args.Placeholder = append(*args.Placeholder, histories...)
+	return nil
+}
+
+func (QueryLifecycleHistories) Method() string {
+	return http.MethodGet
+}
+
+func (QueryLifecycleHistories) Path() string {
+	return "lifecycleHistory/" + version + "/do/query"
+}
+
+func (q QueryLifecycleHistories) Query() (map[string]string, error) {
+	return q.pageQuery(q.Offset, q.Limit), nil
+}
+
+// QueryLifecycleHistoriesPager returns a Pager over every lifecycle
+// history matching q, by ascending id.
+// The offset and sort order of q are ignored, and its limit is the page
+// size.
+func (p *Pargo) QueryLifecycleHistoriesPager(
+	q QueryLifecycleHistories,
+) *Pager[LifecycleHistory] {
+	start := q.IDGreaterThan
+	return NewKeysetPager[LifecycleHistory](p, "lifecycleHistory", q.Limit,
+		func(after, limit int) Endpoint {
+			q.Offset, q.Limit = 0, limit
+			q.IDGreaterThan = max(after, start)
+			q.SortBy, q.SortOrder = "id", "ascending"
+			return q
+		},
+		func(h LifecycleHistory) int { return h.ID })
+}
+
+// StageTransition is a step of the lifecycle timeline of a prospect.
+// From is nil when the prospect entered the funnel, and stages unknown
+// to the timeline only have their ID.
+type StageTransition struct {
+	At      time.Time
+	From    *LifecycleStage
+	To      *LifecycleStage
+	Elapsed time.Duration
+}
+
+// StageTimeline returns the transitions of a prospect among histories,
+// in chronological order, with the stages given.
+func StageTimeline(
+	prospectID int,
+	histories []LifecycleHistory,
+	stages []LifecycleStage,
+) []StageTransition {
+	byID := make(map[int]*LifecycleStage, len(stages))
+	for i := range stages {
+		byID[stages[i].ID] = &stages[i]
+	}
+	stage := func(id int) *LifecycleStage {
+		if id == 0 {
+			return nil
+		}
+		if s, ok := byID[id]; ok {
+			return s
+		}
+		return &LifecycleStage{ID: id}
+	}
+
+	var own []LifecycleHistory
+	for _, h := range histories {
+		if h.ProspectID == prospectID {
+			own = append(own, h)
+		}
+	}
+	// Histories created in the same second keep their id order.
+	sort.SliceStable(own, func(i, j int) bool {
+		if !own[i].CreatedAt.Equal(own[j].CreatedAt.Time) {
+			return own[i].CreatedAt.Before(own[j].CreatedAt.Time)
+		}
+		return own[i].ID < own[j].ID
+	})
+	timeline := make([]StageTransition, 0, len(own))
+	for _, h := range own {
+		timeline = append(timeline, StageTransition{
+			At:      h.CreatedAt.Time,
+			From:    stage(h.PreviousStageID),
+			To:      stage(h.NextStageID),
+			Elapsed: time.Duration(h.SecondsElapsed) * time.Second,
+		})
+	}
+	return timeline
+}
+
+// ProspectStageTimeline returns the lifecycle timeline of a prospect
+// since a date, which may be zero.
+// Pardot cannot filter histories by prospect, so every history created
+// since then is read: keep since as recent as possible.
+func (p *Pargo) ProspectStageTimeline(
+	ctx context.Context,
+	prospectID int,
+	since time.Time,
+) ([]StageTransition, error) {
+	var stages []LifecycleStage
+	for stage, err := range p.QueryLifecycleStagesPager(QueryLifecycleStages{}).All(ctx) {
+		if err != nil {
+			return nil, err
+		}
+		stages = append(stages, stage)
+	}
+	q := QueryLifecycleHistories{QueryFilters: QueryFilters{CreatedAfter: since}}
+	var histories []LifecycleHistory
+	for h, err := range p.QueryLifecycleHistoriesPager(q).All(ctx) {
+		if err != nil {
+			return nil, err
+		}
+		if h.ProspectID == prospectID {
+			histories = append(histories, h)
+		}
+	}
+	return StageTimeline(prospectID, histories, stages), nil
+}
@@ -0,0 +1,89 @@
+package pargo_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/brunoflores/pargo"
+)
+
+func TestProspectStageTimeline(t *testing.T) {
+	// Histories 1 to 250 alternate between prospects 1 and 2, and those
+	// of prospect 1 move it through the stages 1, 2 and 3 and back.
+	client := newCannedClient(func(req *http.Request) string {
+		if strings.Contains(req.URL.Path, "lifecycleStage/") {
+			return `{"result":{"lifecycleStage":[
+				{"id":1,"name":"Lead","position":0},
+				{"id":2,"name":"MQL","position":1},
+				{"id":3,"name":"SQL","position":2}]}}`
+		}
+		if got := req.FormValue("created_after"); got != "2020-01-01 00:00:00" {
+			t.Errorf("created_after = %q", got)
+		}
+		if got := req.FormValue("sort_by"); got != "id" {
+			t.Errorf("sort_by = %q", got)
+		}
+		after, _ := strconv.Atoi(req.FormValue("id_greater_than"))
+		limit, _ := strconv.Atoi(req.FormValue("limit"))
+		var page []string
+		for id := after + 1; id <= 250 && len(page) < limit; id++ {
+			prospect, prev, next := 2, 0, 1
+			if id%2 == 1 {
+				prospect, prev, next = 1, (id/2)%3, (id/2+1)%3
+			}
+			// Created out of id order, one day apart.
+			created := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC).
+				AddDate(0, 0, 250-id)
+			page = append(page, fmt.Sprintf(`{"id":%d,"prospect_id":%d,
+				"previous_stage_id":%d,"next_stage_id":%d,"seconds_elapsed":60,
+				"created_at":%q}`,
+				id, prospect, prev, next, created.Format("2006-01-02 15:04:05")))
+		}
+		return `{"result":{"lifecycleHistory":[` + strings.Join(page, ",") + `]}}`
+	})
+
+	timeline, err := client.ProspectStageTimeline(context.Background(), 1,
+		time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(timeline) != 125 {
+		t.Fatalf("got %d transitions; want 125", len(timeline))
+	}
+	for i, step := range timeline {
+		if i > 0 && step.At.Before(timeline[i-1].At) {
+			t.Fatalf("transition %d at %v is before %v", i, step.At, timeline[i-1].At)
+		}
+		if step.Elapsed != time.Minute {
+			t.Fatalf("got elapsed %v", step.Elapsed)
+		}
+	}
+	// The earliest history is 249, from stage 124%3 = 1 to 2.
+	first := timeline[0]
+	if first.From == nil || first.From.Name != "Lead" || first.To.Name != "MQL" {
+		t.Fatalf("got first transition %+v", first)
+	}
+	// The latest is 1, entering the funnel at stage 1.
+	last := timeline[len(timeline)-1]
+	if last.From != nil || last.To.Name != "Lead" {
+		t.Fatalf("got last transition %+v", last)
+	}
+}
+
+func TestStageTimelineUnknownStage(t *testing.T) {
+	at := pargo.Time{Time: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)}
+	timeline := pargo.StageTimeline(1, []pargo.LifecycleHistory{
+		{ID: 2, ProspectID: 1, PreviousStageID: 1, NextStageID: 9, CreatedAt: at},
+		{ID: 1, ProspectID: 1, NextStageID: 1, CreatedAt: at},
+		{ID: 3, ProspectID: 5, NextStageID: 1, CreatedAt: at},
+	}, []pargo.LifecycleStage{{ID: 1, Name: "Lead"}})
+	if len(timeline) != 2 || timeline[0].To.Name != "Lead" ||
+		timeline[1].To.ID != 9 || timeline[1].To.Name != "" {
+		t.Fatalf("got %+v", timeline)
+	}
+}
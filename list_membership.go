@@ -1,9 +1,12 @@
 package pargo
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/pkg/errors"
 )
@@ -13,13 +16,24 @@ type ListMemberships struct {
 	ListID        int
 	Offset, Limit int
 	Placeholder   *[]ListMembership
+
+	// Optional filters.
+	// Zero values are not sent to Pardot.
+	IDGreaterThan, IDLessThan   int
+	CreatedAfter, CreatedBefore time.Time
+	UpdatedAfter, UpdatedBefore time.Time
+	OptedOut                    *bool
 }
 
 // ListMembership is an instance of a list membership.
 // A link between a prospect and a list.
 type ListMembership struct {
-	ListID     int `json:"list_id"`
-	ProspectID int `json:"prospect_id"`
+	ID         int  `json:"id,omitempty"`
+	ListID     int  `json:"list_id"`
+	ProspectID int  `json:"prospect_id"`
+	OptedOut   Bool `json:"opted_out"`
+	CreatedAt  Time `json:"created_at"`
+	UpdatedAt  Time `json:"updated_at"`
 }
 
 // ListMemberships executes the endpoint with arguments.
@@ -52,38 +66,155 @@ func (q ListMemberships) Query() (map[string]string, error) {
 	query := make(map[string]string)
 	query["offset"] = strconv.Itoa(q.Offset)
 	query["limit"] = strconv.Itoa(q.Limit)
-	query["list_id"] = strconv.Itoa(q.ListID)
+	if q.ListID > 0 {
+		query["list_id"] = strconv.Itoa(q.ListID)
+	}
+	addIDRange(query, q.IDGreaterThan, q.IDLessThan)
+	addTimeRange(query, "created", q.CreatedAfter, q.CreatedBefore)
+	addTimeRange(query, "updated", q.UpdatedAfter, q.UpdatedBefore)
+	if q.OptedOut != nil {
+		query["opted_out"] = strconv.FormatBool(*q.OptedOut)
+	}
 	return query, nil
 }
 
 func (q ListMemberships) readListMembership(res []byte) error {
 	body := struct {
 		Result struct {
-			Total int             `json:"total_results"`
-			List  json.RawMessage `json:"list_membership"`
+			List json.RawMessage `json:"list_membership"`
 		} `json:"result"`
 	}{}
 	// Discard error and assume that the JSON from Pardot is valid.
 	_ = json.Unmarshal(res, &body)
 
-	// Got an empty page.
-	if body.Result.List == nil {
-		return nil
+	// Pardot returns a single object instead of an array when there is
+	// only one membership, and no key at all for an empty page.
+	memberships, err := decodeRecords[ListMembership](body.Result.List)
+	if err != nil {
+		return errors.Wrap(err, "unmarshaling memberships")
 	}
+	*q.Placeholder = append(*q.Placeholder, memberships...)
+	return nil
+}
 
-	switch i := body.Result.Total; i {
-	case 1:
-		var p ListMembership
-		err := json.Unmarshal(body.Result.List, &p)
-		if err != nil {
-			return errors.Wrap(err, "unmarshaling single membership")
-		}
-		*q.Placeholder = append(*q.Placeholder, p)
-	default:
-		err := json.Unmarshal(body.Result.List, q.Placeholder)
-		if err != nil {
-			return errors.Wrap(err, "unmarshaling memberships")
-		}
+// membershipPath is the path to act on a membership, either by its id
+// or by the pair of list and prospect ids.
+func membershipPath(action string, id, listID, prospectID int) string {
+	if id > 0 {
+		return fmt.Sprintf("listMembership/%s/do/%s/id/%d",
+			version, action, id)
 	}
-	return nil
+	return fmt.Sprintf("listMembership/%s/do/%s/list_id/%d/prospect_id/%d",
+		version, action, listID, prospectID)
+}
+
+// ReadListMembership is an endpoint to read a membership, by ID or by
+// ListID and ProspectID.
+type ReadListMembership struct {
+	ID                 int
+	ListID, ProspectID int
+	Placeholder        *ListMembership
+}
+
+// ReadListMembership executes the endpoint with arguments.
+func (p *Pargo) ReadListMembership(args ReadListMembership) error {
+	body, err := p.do(context.Background(), args)
+	if err != nil {
+		return err
+	}
+	return readRecord(body, "list_membership", args.Placeholder)
+}
+
+func (ReadListMembership) Method() string {
+	return http.MethodGet
+}
+
+func (q ReadListMembership) Path() string {
+	return membershipPath("read", q.ID, q.ListID, q.ProspectID)
+}
+
+// CreateListMembership is an endpoint to add a prospect to a list.
+// The optional Placeholder receives the membership created.
+type CreateListMembership struct {
+	ListID, ProspectID int
+	OptedOut           bool
+	Placeholder        *ListMembership
+}
+
+// CreateListMembership executes the endpoint with arguments.
+func (p *Pargo) CreateListMembership(args CreateListMembership) error {
+	body, err := p.do(context.Background(), args)
+	if err != nil {
+		return err
+	}
+	return readRecord(body, "list_membership", args.Placeholder)
+}
+
+func (CreateListMembership) Method() string {
+	return http.MethodPost
+}
+
+func (q CreateListMembership) Path() string {
+	return membershipPath("create", 0, q.ListID, q.ProspectID)
+}
+
+func (q CreateListMembership) Query() (map[string]string, error) {
+	if !q.OptedOut {
+		return nil, nil
+	}
+	return map[string]string{"opted_out": "true"}, nil
+}
+
+// UpdateListMembership is an endpoint to opt a prospect in or out of a
+// list, by ID or by ListID and ProspectID.
+// The optional Placeholder receives the membership updated.
+type UpdateListMembership struct {
+	ID                 int
+	ListID, ProspectID int
+	OptedOut           bool
+	Placeholder        *ListMembership
+}
+
+// UpdateListMembership executes the endpoint with arguments.
+func (p *Pargo) UpdateListMembership(args UpdateListMembership) error {
+	body, err := p.do(context.Background(), args)
+	if err != nil {
+		return err
+	}
+	return readRecord(body, "list_membership", args.Placeholder)
+}
+
+func (UpdateListMembership) Method() string {
+	return http.MethodPost
+}
+
+func (q UpdateListMembership) Path() string {
+	return membershipPath("update", q.ID, q.ListID, q.ProspectID)
+}
+
+func (q UpdateListMembership) Query() (map[string]string, error) {
+	return map[string]string{
+		"opted_out": strconv.FormatBool(q.OptedOut),
+	}, nil
+}
+
+// DeleteListMembership is an endpoint to remove a prospect from a list,
+// by ID or by ListID and ProspectID.
+type DeleteListMembership struct {
+	ID                 int
+	ListID, ProspectID int
+}
+
+// DeleteListMembership executes the endpoint with arguments.
+func (p *Pargo) DeleteListMembership(args DeleteListMembership) error {
+	_, err := p.do(context.Background(), args)
+	return err
+}
+
+func (DeleteListMembership) Method() string {
+	return http.MethodPost
+}
+
+func (q DeleteListMembership) Path() string {
+	return membershipPath("delete", q.ID, q.ListID, q.ProspectID)
 }
@@ -0,0 +1,227 @@
+package pargo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// ProspectsToList is the set of arguments to add prospects to, or
+// remove them from, a list in bulk.
+// Prospects may be given by id, by email, or both.
+type ProspectsToList struct {
+	ListID      int
+	ProspectIDs []int
+	Emails      []string
+}
+
+// ListResultStatus is what happened to one prospect in a bulk operation.
+type ListResultStatus int
+
+const (
+	// ListFailed means the prospect could not be added or removed.
+	// The result has the error.
+	ListFailed ListResultStatus = iota
+
+	// ListAdded means the prospect was added to the list.
+	ListAdded
+
+	// ListRemoved means the prospect was removed from the list.
+	ListRemoved
+
+	// ListSkipped means the prospect was already in the list when
+	// adding, or not in it when removing.
+	ListSkipped
+)
+
+// ListResult is the outcome for one prospect of a bulk operation.
+// Email is set when the prospect was given by email; an email matching
+// several prospects has a result for each of them.
+type ListResult struct {
+	ProspectID int
+	Email      string
+	Status     ListResultStatus
+	Err        error
+}
+
+// AddProspectsToList adds every prospect to the list, sharing the
+// requests in flight allowed by the client.
+// Prospects already in the list are skipped.
+// Results are in the order the prospects were given, ids first.
+func (p *Pargo) AddProspectsToList(args ProspectsToList) ([]ListResult, error) {
+	return p.prospectsToList(args, func(r ListResult) ListResult {
+		err := p.CreateListMembership(CreateListMembership{
+			ListID:     args.ListID,
+			ProspectID: r.ProspectID,
+		})
+		if err == nil {
+			r.Status = ListAdded
+			return r
+		}
+		member, readErr := p.isListMember(args.ListID, r.ProspectID)
+		if readErr == nil && member {
+			r.Status = ListSkipped
+		} else {
+			r.Err = err
+		}
+		return r
+	})
+}
+
+// RemoveProspectsFromList removes every prospect from the list, sharing
+// the requests in flight allowed by the client.
+// Prospects not in the list are skipped.
+// Results are in the order the prospects were given, ids first.
+func (p *Pargo) RemoveProspectsFromList(args ProspectsToList) ([]ListResult, error) {
+	return p.prospectsToList(args, func(r ListResult) ListResult {
+		err := p.DeleteListMembership(DeleteListMembership{
+			ListID:     args.ListID,
+			ProspectID: r.ProspectID,
+		})
+		if err == nil {
+			r.Status = ListRemoved
+			return r
+		}
+		member, readErr := p.isListMember(args.ListID, r.ProspectID)
+		if readErr == nil && !member {
+			r.Status = ListSkipped
+		} else {
+			r.Err = err
+		}
+		return r
+	})
+}
+
+// errInvalidListMembership is the error code Pardot answers with for a
+// list membership that does not exist.
+const errInvalidListMembership = 37
+
+// isListMember tells whether the prospect is in the list.
+// Pardot has no dedicated error code for an existing membership, so it
+// is checked after a failed change. Only an invalid membership answer
+// means the prospect is not a member; other errors are returned.
+func (p *Pargo) isListMember(listID, prospectID int) (bool, error) {
+	var m ListMembership
+	err := p.ReadListMembership(ReadListMembership{
+		ListID:      listID,
+		ProspectID:  prospectID,
+		Placeholder: &m,
+	})
+	if err != nil {
+		if apiErr, ok := errors.Cause(err).(ErrAPI); ok &&
+			apiErr.Code == errInvalidListMembership {
+			return false, nil
+		}
+		return false, err
+	}
+	return m.ProspectID == prospectID, nil
+}
+
+// prospectsToList resolves the prospects then applies fn to each of
+// them, from as many goroutines as the client allows requests in flight.
+func (p *Pargo) prospectsToList(
+	args ProspectsToList,
+	fn func(ListResult) ListResult,
+) ([]ListResult, error) {
+	if args.ListID <= 0 {
+		return nil, errors.New("missing list id")
+	}
+	workers := maxWorkers
+	if p.limiter != nil {
+		workers = cap(p.limiter)
+	}
+
+	// Emails are resolved to ids first, in parallel as well.
+	resolved := make([][]ListResult, len(args.Emails))
+	parallel(workers, len(args.Emails), func(i int) {
+		email := args.Emails[i]
+		ids, err := p.prospectIDsByEmail(email)
+		if err != nil {
+			resolved[i] = []ListResult{{Email: email, Err: err}}
+			return
+		}
+		for _, id := range ids {
+			resolved[i] = append(resolved[i],
+				ListResult{ProspectID: id, Email: email})
+		}
+	})
+
+	results := make([]ListResult, 0, len(args.ProspectIDs)+len(args.Emails))
+	for _, id := range args.ProspectIDs {
+		results = append(results, ListResult{ProspectID: id})
+	}
+	for _, r := range resolved {
+		results = append(results, r...)
+	}
+
+	parallel(workers, len(results), func(i int) {
+		if results[i].Err != nil {
+			return
+		}
+		results[i] = fn(results[i])
+	})
+	return results, nil
+}
+
+// parallel calls fn with every index up to n, from at most workers
+// goroutines, and returns once all calls have returned.
+func parallel(workers, n int, fn func(i int)) {
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < workers && w < n; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				fn(i)
+			}
+		}()
+	}
+	for i := 0; i < n; i++ {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+}
+
+// readProspectByEmail is an endpoint to read the prospects with an
+// email address.
+type readProspectByEmail struct {
+	email string
+}
+
+func (readProspectByEmail) Method() string {
+	return http.MethodGet
+}
+
+func (q readProspectByEmail) Path() string {
+	return fmt.Sprintf("prospect/%s/do/read/email/%s", version, q.email)
+}
+
+// prospectIDsByEmail returns the ids of the prospects with the email.
+// Pardot allows several prospects to share an email.
+func (p *Pargo) prospectIDsByEmail(email string) ([]int, error) {
+	body, err := p.do(context.Background(), readProspectByEmail{email})
+	if err != nil {
+		return nil, err
+	}
+	var raw json.RawMessage
+	if err := readRecord(body, "prospect", &raw); err != nil {
+		return nil, err
+	}
+	prospects, err := decodeRecords[struct {
+		ID int `json:"id"`
+	}](raw)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]int, 0, len(prospects))
+	for _, prospect := range prospects {
+		ids = append(ids, prospect.ID)
+	}
+	return ids, nil
+}
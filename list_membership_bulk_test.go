@@ -0,0 +1,178 @@
+package pargo_test
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"regexp"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/brunoflores/pargo"
+)
+
+func TestAddAndRemoveProspectsToList(t *testing.T) {
+	const concurrency = 2
+	var (
+		mu              sync.Mutex
+		members         = map[int]bool{2: true}
+		inFlight, maxIn int
+	)
+	emails := map[string]string{
+		"a@b.com":   `{"id":3}`,
+		"dup@b.com": `[{"id":4},{"id":5}]`,
+	}
+	membership := regexp.MustCompile(
+		`/listMembership/version/4/do/(\w+)/list_id/7/prospect_id/(\d+)$`)
+	byEmail := regexp.MustCompile(`/prospect/version/4/do/read/email/(.+)$`)
+	respond := func(body string) *http.Response {
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+			Header:     make(http.Header)}
+	}
+	const fail = `{"@attributes":{"err_code":%d},"err":"%s"}`
+	testClient := newTestHTTPClient(func(req *http.Request) *http.Response {
+		u := req.URL.Path
+		if u == "/services/oauth2/token" {
+			return respond(`{}`)
+		}
+		mu.Lock()
+		inFlight++
+		if inFlight > maxIn {
+			maxIn = inFlight
+		}
+		mu.Unlock()
+		defer func() {
+			mu.Lock()
+			inFlight--
+			mu.Unlock()
+		}()
+
+		if m := byEmail.FindStringSubmatch(u); m != nil {
+			if p, ok := emails[m[1]]; ok {
+				return respond(`{"prospect":` + p + `}`)
+			}
+			return respond(fmt.Sprintf(fail, 4, "Invalid prospect email address"))
+		}
+		m := membership.FindStringSubmatch(u)
+		if m == nil {
+			t.Errorf("unknown endpoint called %q", u)
+			return respond(`{}`)
+		}
+		id, _ := strconv.Atoi(m[2])
+		mu.Lock()
+		defer mu.Unlock()
+		ok := `{"list_membership":{"list_id":7,"prospect_id":` + m[2] + `}}`
+		switch m[1] {
+		case "read":
+			if members[id] {
+				return respond(ok)
+			}
+			return respond(fmt.Sprintf(fail, 37, "Invalid list membership ID"))
+		case "create":
+			if members[id] {
+				return respond(fmt.Sprintf(fail, 61, "Already a member"))
+			}
+			members[id] = true
+			return respond(ok)
+		case "delete":
+			if !members[id] {
+				return respond(fmt.Sprintf(fail, 37, "Invalid list membership ID"))
+			}
+			delete(members, id)
+			return respond(``)
+		}
+		t.Errorf("unknown action %q", m[1])
+		return respond(`{}`)
+	})
+	client := pargo.NewPargo(pargo.UserAccount{}, "unit",
+		pargo.WithCustomClient(testClient),
+		pargo.WithConcurrency(concurrency))
+
+	type result struct {
+		id     int
+		email  string
+		status pargo.ListResultStatus
+		failed bool
+	}
+	check := func(got []pargo.ListResult, want []result) {
+		t.Helper()
+		if len(got) != len(want) {
+			t.Fatalf("got %d results; want %d", len(got), len(want))
+		}
+		for i, r := range got {
+			g := result{r.ProspectID, r.Email, r.Status, r.Err != nil}
+			if g != want[i] {
+				t.Errorf("result %d: got %+v; want %+v", i, g, want[i])
+			}
+		}
+	}
+
+	args := pargo.ProspectsToList{
+		ListID:      7,
+		ProspectIDs: []int{1, 2},
+		Emails:      []string{"a@b.com", "dup@b.com", "none@b.com"},
+	}
+	results, err := client.AddProspectsToList(args)
+	if err != nil {
+		t.Fatal(err)
+	}
+	check(results, []result{
+		{1, "", pargo.ListAdded, false},
+		{2, "", pargo.ListSkipped, false},
+		{3, "a@b.com", pargo.ListAdded, false},
+		{4, "dup@b.com", pargo.ListAdded, false},
+		{5, "dup@b.com", pargo.ListAdded, false},
+		{0, "none@b.com", pargo.ListFailed, true},
+	})
+
+	args.ProspectIDs = []int{1, 6}
+	args.Emails = nil
+	results, err = client.RemoveProspectsFromList(args)
+	if err != nil {
+		t.Fatal(err)
+	}
+	check(results, []result{
+		{1, "", pargo.ListRemoved, false},
+		{6, "", pargo.ListSkipped, false},
+	})
+
+	if maxIn > concurrency {
+		t.Fatalf("%d requests in flight; want at most %d", maxIn, concurrency)
+	}
+	if _, err := client.AddProspectsToList(pargo.ProspectsToList{}); err == nil {
+		t.Fatal("want error without a list id; got nil")
+	}
+}
+
+func TestRemoveProspectsFromListUnavailable(t *testing.T) {
+	// Both the delete and the read that follows it fail, so whether the
+	// prospect was in the list is unknown.
+	client := newTestClient(newTestHTTPClient(func(req *http.Request) *http.Response {
+		code, body := 503, `Service Unavailable`
+		if req.URL.Path == "/services/oauth2/token" {
+			code, body = 200, `{}`
+		}
+		return &http.Response{
+			StatusCode: code,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+			Header:     make(http.Header)}
+	}))
+
+	for name, call := range map[string]func(pargo.ProspectsToList) ([]pargo.ListResult, error){
+		"add":    client.AddProspectsToList,
+		"remove": client.RemoveProspectsFromList,
+	} {
+		results, err := call(pargo.ProspectsToList{ListID: 7, ProspectIDs: []int{1}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(results) != 1 || results[0].Status != pargo.ListFailed ||
+			results[0].Err == nil {
+			t.Errorf("%s: got %+v; want ListFailed with an error", name, results)
+		}
+	}
+}
@@ -4,10 +4,13 @@ import (
 	"bytes"
 	"io/ioutil"
 	"net/http"
+	"net/url"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/brunoflores/pargo"
+	"github.com/pkg/errors"
 )
 
 func TestReadsAList(t *testing.T) {
@@ -120,3 +123,132 @@ func TestReadsEmptyPage(t *testing.T) {
 		t.Fatalf("expected 0 memberships, got %d", len(memberships))
 	}
 }
+
+func TestListMembershipCRUD(t *testing.T) {
+	const membership = `{"list_membership":{"id":9,"list_id":24323,` +
+		`"prospect_id":7666184,"opted_out":"1",` +
+		`"created_at":"2019-03-11 06:56:38","updated_at":null}}`
+	var gotPath, gotMethod, gotOptedOut string
+	testClient := newTestHTTPClient(func(req *http.Request) *http.Response {
+		u := req.URL.Path
+		if strings.Contains(u, `oauth2/`) {
+			return &http.Response{
+				StatusCode: 200,
+				Body:       ioutil.NopCloser(bytes.NewBufferString(`{}`)),
+				Header:     make(http.Header)}
+		}
+		gotPath, gotMethod = u, req.Method
+		gotOptedOut = req.FormValue("opted_out")
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(membership)),
+			Header:     make(http.Header)}
+	})
+	pardot := newTestClient(testClient)
+
+	tests := []struct {
+		call                   func() error
+		path, method, optedOut string
+	}{
+		{
+			func() error {
+				var m pargo.ListMembership
+				err := pardot.ReadListMembership(pargo.ReadListMembership{
+					ID: 9, Placeholder: &m})
+				if err == nil && (!bool(m.OptedOut) || m.CreatedAt.Year() != 2019 ||
+					!m.UpdatedAt.IsZero() || m.ProspectID != 7666184) {
+					t.Errorf("got %+v", m)
+				}
+				return err
+			},
+			"/api/listMembership/version/4/do/read/id/9", "GET", "",
+		},
+		{
+			func() error {
+				return pardot.CreateListMembership(pargo.CreateListMembership{
+					ListID: 24323, ProspectID: 7666184, OptedOut: true})
+			},
+			"/api/listMembership/version/4/do/create/list_id/24323/prospect_id/7666184",
+			"POST", "true",
+		},
+		{
+			func() error {
+				return pardot.UpdateListMembership(pargo.UpdateListMembership{
+					ListID: 24323, ProspectID: 7666184})
+			},
+			"/api/listMembership/version/4/do/update/list_id/24323/prospect_id/7666184",
+			"POST", "false",
+		},
+		{
+			func() error {
+				return pardot.DeleteListMembership(pargo.DeleteListMembership{ID: 9})
+			},
+			"/api/listMembership/version/4/do/delete/id/9", "POST", "",
+		},
+	}
+	for _, test := range tests {
+		if err := test.call(); err != nil {
+			t.Fatal(err)
+		}
+		if gotPath != test.path || gotMethod != test.method {
+			t.Errorf("got %s %s; want %s %s",
+				gotMethod, gotPath, test.method, test.path)
+		}
+		if gotOptedOut != test.optedOut {
+			t.Errorf("%s: opted_out = %q; want %q",
+				test.path, gotOptedOut, test.optedOut)
+		}
+	}
+}
+
+func TestListMembershipsFilters(t *testing.T) {
+	var got url.Values
+	testClient := newTestHTTPClient(func(req *http.Request) *http.Response {
+		got = req.URL.Query()
+		return &http.Response{
+			StatusCode: 200,
+			Body: ioutil.NopCloser(bytes.NewBufferString(
+				`{"result":{"total_results":0}}`)),
+			Header: make(http.Header)}
+	})
+	optedOut := false
+	var memberships []pargo.ListMembership
+	err := newTestClient(testClient).ListMemberships(pargo.ListMemberships{
+		Limit:         200,
+		IDGreaterThan: 5,
+		CreatedAfter:  time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC),
+		OptedOut:      &optedOut,
+		Placeholder:   &memberships,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]string{
+		"id_greater_than": "5",
+		"created_after":   "2020-01-02 03:04:05",
+		"opted_out":       "false",
+		"list_id":         "",
+		"updated_after":   "",
+	}
+	for k, v := range want {
+		if got.Get(k) != v {
+			t.Errorf("%s = %q; want %q", k, got.Get(k), v)
+		}
+	}
+}
+
+func TestReadListMembershipError(t *testing.T) {
+	testClient := newTestHTTPClient(func(req *http.Request) *http.Response {
+		return &http.Response{
+			StatusCode: 200,
+			Body: ioutil.NopCloser(bytes.NewBufferString(
+				`{"@attributes":{"stat":"fail","err_code":37},"err":"Invalid list membership ID"}`)),
+			Header: make(http.Header)}
+	})
+	err := newTestClient(testClient).ReadListMembership(
+		pargo.ReadListMembership{ID: 1})
+	apiErr, ok := errors.Cause(err).(pargo.ErrAPI)
+	if !ok || apiErr.Code != 37 {
+		t.Fatalf("got %v; want ErrAPI with code 37", err)
+	}
+}
@@ -0,0 +1,183 @@
+package pargo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+// List is a list of prospects.
+type List struct {
+	ID           int    `json:"id"`
+	Name         string `json:"name"`
+	Title        string `json:"title"`
+	Description  string `json:"description"`
+	IsPublic     Bool   `json:"is_public"`
+	IsDynamic    Bool   `json:"is_dynamic"`
+	IsCRMVisible Bool   `json:"is_crm_visible"`
+	CreatedAt    Time   `json:"created_at"`
+	UpdatedAt    Time   `json:"updated_at"`
+}
+
+// QueryLists is an endpoint to query a page of lists.
+type QueryLists struct {
+	Offset, Limit int
+	QueryFilters
+	Placeholder *[]List
+}
+
+// QueryLists executes the endpoint with arguments.
+func (p *Pargo) QueryLists(args QueryLists) error {
+	body, err := p.do(context.Background(), args)
+	if err != nil {
+		return err
+	}
+	lists, err := readRecords[List](body, "list")
+	if err != nil {
+		return err
+	}
+	*args.Placeholder = append(*args.Placeholder, lists...)
+	return nil
+}
+
+func (QueryLists) Method() string {
+	return http.MethodGet
+}
+
+func (QueryLists) Path() string {
+	return "list/" + version + "/do/query"
+}
+
+func (q QueryLists) Query() (map[string]string, error) {
+	return q.pageQuery(q.Offset, q.Limit), nil
+}
+
+// QueryListsPager returns a Pager over every list matching q.
+// The offset of q is ignored and its limit is the page size.
+func (p *Pargo) QueryListsPager(q QueryLists) *Pager[List] {
+	return NewPager[List](p, "list", q.Limit,
+		func(offset, limit int) Endpoint {
+			q.Offset, q.Limit = offset, limit
+			return q
+		})
+}
+
+// ReadList is an endpoint to read a list by id.
+type ReadList struct {
+	ID          int
+	Placeholder *List
+}
+
+// ReadList executes the endpoint with arguments.
+func (p *Pargo) ReadList(args ReadList) error {
+	body, err := p.do(context.Background(), args)
+	if err != nil {
+		return err
+	}
+	return readRecord(body, "list", args.Placeholder)
+}
+
+func (ReadList) Method() string {
+	return http.MethodGet
+}
+
+func (q ReadList) Path() string {
+	return fmt.Sprintf("list/%s/do/read/id/%d", version, q.ID)
+}
+
+// CreateList is an endpoint to create a list.
+// Pardot does not allow the criteria of dynamic lists to be set through
+// the API, so lists created are static.
+// The optional Placeholder receives the list created.
+type CreateList struct {
+	Name               string
+	Title, Description string
+	IsPublic           bool
+	IsCRMVisible       bool
+	Placeholder        *List
+}
+
+// CreateList executes the endpoint with arguments.
+func (p *Pargo) CreateList(args CreateList) error {
+	body, err := p.do(context.Background(), args)
+	if err != nil {
+		return err
+	}
+	return readRecord(body, "list", args.Placeholder)
+}
+
+func (CreateList) Method() string {
+	return http.MethodPost
+}
+
+func (CreateList) Path() string {
+	return "list/" + version + "/do/create"
+}
+
+func (q CreateList) Query() (map[string]string, error) {
+	query := map[string]string{
+		"name":           q.Name,
+		"is_public":      strconv.FormatBool(q.IsPublic),
+		"is_crm_visible": strconv.FormatBool(q.IsCRMVisible),
+	}
+	addString(query, "title", q.Title)
+	addString(query, "description", q.Description)
+	return query, nil
+}
+
+// UpdateList is an endpoint to update a list by id.
+// Empty and nil fields are left unchanged.
+// The optional Placeholder receives the list updated.
+type UpdateList struct {
+	ID                       int
+	Name, Title, Description string
+	IsPublic, IsCRMVisible   *bool
+	Placeholder              *List
+}
+
+// UpdateList executes the endpoint with arguments.
+func (p *Pargo) UpdateList(args UpdateList) error {
+	body, err := p.do(context.Background(), args)
+	if err != nil {
+		return err
+	}
+	return readRecord(body, "list", args.Placeholder)
+}
+
+func (UpdateList) Method() string {
+	return http.MethodPost
+}
+
+func (q UpdateList) Path() string {
+	return fmt.Sprintf("list/%s/do/update/id/%d", version, q.ID)
+}
+
+func (q UpdateList) Query() (map[string]string, error) {
+	query := make(map[string]string)
+	addString(query, "name", q.Name)
+	addString(query, "title", q.Title)
+	addString(query, "description", q.Description)
+	addBool(query, "is_public", q.IsPublic)
+	addBool(query, "is_crm_visible", q.IsCRMVisible)
+	return query, nil
+}
+
+// DeleteList is an endpoint to delete a list by id.
+type DeleteList struct {
+	ID int
+}
+
+// DeleteList executes the endpoint with arguments.
+func (p *Pargo) DeleteList(args DeleteList) error {
+	_, err := p.do(context.Background(), args)
+	return err
+}
+
+func (DeleteList) Method() string {
+	return http.MethodPost
+}
+
+func (q DeleteList) Path() string {
+	return fmt.Sprintf("list/%s/do/delete/id/%d", version, q.ID)
+}
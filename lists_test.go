@@ -0,0 +1,105 @@
+package pargo_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/brunoflores/pargo"
+)
+
+const testList = `{"id":12,"name":"Newsletter","title":"Monthly news",` +
+	`"description":"","is_public":true,"is_dynamic":false,` +
+	`"is_crm_visible":"0","created_at":"2020-05-01 10:00:00",` +
+	`"updated_at":"2020-05-02 10:00:00"}`
+
+func TestListCRUD(t *testing.T) {
+	var got *http.Request
+	client := newCannedClient(func(req *http.Request) string {
+		got = req
+		return `{"list":` + testList + `}`
+	})
+
+	var list pargo.List
+	if err := client.ReadList(pargo.ReadList{ID: 12, Placeholder: &list}); err != nil {
+		t.Fatal(err)
+	}
+	if list.Name != "Newsletter" || !bool(list.IsPublic) ||
+		bool(list.IsCRMVisible) || list.UpdatedAt.Day() != 2 {
+		t.Fatalf("got %+v", list)
+	}
+
+	public := false
+	checkEndpointCalls(t, &got, []endpointCall{
+		{
+			func() error {
+				return client.CreateList(pargo.CreateList{
+					Name: "Webinar", IsPublic: true, Placeholder: &list})
+			},
+			"POST", "/api/list/version/4/do/create",
+			map[string]string{"name": "Webinar", "is_public": "true",
+				"is_crm_visible": "false"},
+		},
+		{
+			func() error {
+				return client.UpdateList(pargo.UpdateList{
+					ID: 12, Title: "Webinars", IsPublic: &public})
+			},
+			"POST", "/api/list/version/4/do/update/id/12",
+			map[string]string{"title": "Webinars", "is_public": "false"},
+		},
+		{
+			func() error { return client.DeleteList(pargo.DeleteList{ID: 12}) },
+			"POST", "/api/list/version/4/do/delete/id/12", nil,
+		},
+	})
+}
+
+func TestQueryListsPager(t *testing.T) {
+	client := newCannedClient(func(req *http.Request) string {
+		if got := req.FormValue("created_after"); got != "2020-01-01 00:00:00" {
+			t.Errorf("created_after = %q", got)
+		}
+		offset, _ := strconv.Atoi(req.FormValue("offset"))
+		var lists []string
+		for id := offset + 1; id <= 5 && id <= offset+2; id++ {
+			lists = append(lists, fmt.Sprintf(`{"id":%d}`, id))
+		}
+		return `{"result":{"list":[` + strings.Join(lists, ",") + `]}}`
+	})
+	pager := client.QueryListsPager(pargo.QueryLists{
+		Limit: 2,
+		QueryFilters: pargo.QueryFilters{
+			CreatedAfter: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+	})
+	var ids []int
+	for list, err := range pager.All(context.Background()) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, list.ID)
+	}
+	if len(ids) != 5 || ids[4] != 5 {
+		t.Fatalf("got %v; want lists 1 to 5", ids)
+	}
+
+	var page []pargo.List
+	err := client.QueryLists(pargo.QueryLists{
+		Offset: 4, Limit: 2,
+		QueryFilters: pargo.QueryFilters{
+			CreatedAfter: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		Placeholder: &page,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(page) != 1 || page[0].ID != 5 {
+		t.Fatalf("got %+v; want list 5", page)
+	}
+}
@@ -0,0 +1,280 @@
+package pargo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+// Opportunity is a sale in progress with one or more prospects, for
+// accounts not synced to a CRM.
+type Opportunity struct {
+	ID          int       `json:"id"`
+	Name        string    `json:"name"`
+	Value       float64   `json:"value"`
+	Probability int       `json:"probability"`
+	Type        string    `json:"type"`
+	Stage       string    `json:"stage"`
+	Status      string    `json:"status"`
+	ClosedAt    Time      `json:"closed_at"`
+	Campaign    *Campaign `json:"campaign,omitempty"`
+	CreatedAt   Time      `json:"created_at"`
+	UpdatedAt   Time      `json:"updated_at"`
+}
+
+// Opportunity statuses.
+const (
+	OpportunityOpen = "open"
+	OpportunityWon  = "won"
+	OpportunityLost = "lost"
+)
+
+// QueryOpportunities is an endpoint to query a page of opportunities.
+type QueryOpportunities struct {
+	Offset, Limit int
+	QueryFilters
+
+	// Optional filters.
+	// Zero values are not sent to Pardot.
+	ProbabilityGreaterThan, ProbabilityLessThan int
+	ValueGreaterThan, ValueLessThan             float64
+	ProspectID                                  int
+	ProspectEmail                               string
+
+	Placeholder *[]Opportunity
+}
+
+// QueryOpportunities executes the endpoint with arguments.
+func (p *Pargo) QueryOpportunities(args QueryOpportunities) error {
+	body, err := p.do(context.Background(), args)
+	if err != nil {
+		return err
+	}
+	opportunities, err := readRecords[Opportunity](body, "opportunity")
+	if err != nil {
+		return err
+	}
+	*args.Placeholder = append(*args.Placeholder, opportunities...)
+	return nil
+}
+
+func (QueryOpportunities) Method() string {
+	return http.MethodGet
+}
+
+func (QueryOpportunities) Path() string {
+	return "opportunity/" + version + "/do/query"
+}
+
+func (q QueryOpportunities) Query() (map[string]string, error) {
+	query := q.pageQuery(q.Offset, q.Limit)
+	if q.ProbabilityGreaterThan > 0 {
+		query["probability_greater_than"] = strconv.Itoa(q.ProbabilityGreaterThan)
+	}
+	if q.ProbabilityLessThan > 0 {
+		query["probability_less_than"] = strconv.Itoa(q.ProbabilityLessThan)
+	}
+	if q.ValueGreaterThan > 0 {
+		query["value_greater_than"] = formatFloat(q.ValueGreaterThan)
+	}
+	if q.ValueLessThan > 0 {
+		query["value_less_than"] = formatFloat(q.ValueLessThan)
+	}
+	if q.ProspectID > 0 {
+		query["prospect_id"] = strconv.Itoa(q.ProspectID)
+	}
+	addString(query, "prospect_email", q.ProspectEmail)
+	return query, nil
+}
+
+// QueryOpportunitiesPager returns a Pager over every opportunity
+// matching q. The offset of q is ignored and its limit is the page size.
+func (p *Pargo) QueryOpportunitiesPager(q QueryOpportunities) *Pager[Opportunity] {
+	return NewPager[Opportunity](p, "opportunity", q.Limit,
+		func(offset, limit int) Endpoint {
+			q.Offset, q.Limit = offset, limit
+			return q
+		})
+}
+
+// ReadOpportunity is an endpoint to read an opportunity by id.
+type ReadOpportunity struct {
+	ID          int
+	Placeholder *Opportunity
+}
+
+// ReadOpportunity executes the endpoint with arguments.
+func (p *Pargo) ReadOpportunity(args ReadOpportunity) error {
+	body, err := p.do(context.Background(), args)
+	if err != nil {
+		return err
+	}
+	return readRecord(body, "opportunity", args.Placeholder)
+}
+
+func (ReadOpportunity) Method() string {
+	return http.MethodGet
+}
+
+func (q ReadOpportunity) Path() string {
+	return fmt.Sprintf("opportunity/%s/do/read/id/%d", version, q.ID)
+}
+
+// OpportunityFields are the optional fields set when creating or
+// updating an opportunity. Empty fields are not sent to Pardot.
+type OpportunityFields struct {
+	Type       string
+	Stage      string
+	Status     string
+	CampaignID int
+	ClosedAt   Time
+}
+
+func (f OpportunityFields) add(query map[string]string) {
+	addString(query, "type", f.Type)
+	addString(query, "stage", f.Stage)
+	addString(query, "status", f.Status)
+	if f.CampaignID > 0 {
+		query["campaign_id"] = strconv.Itoa(f.CampaignID)
+	}
+	if !f.ClosedAt.IsZero() {
+		query["closed_at"] = f.ClosedAt.Format(timeLayout)
+	}
+}
+
+// CreateOpportunity is an endpoint to create an opportunity for a
+// prospect, given by ProspectID or ProspectEmail.
+// Name, Value and Probability are required by Pardot and always sent.
+// The optional Placeholder receives the opportunity created.
+type CreateOpportunity struct {
+	ProspectID    int
+	ProspectEmail string
+	Name          string
+	Value         float64
+	Probability   int
+	OpportunityFields
+	Placeholder *Opportunity
+}
+
+// CreateOpportunity executes the endpoint with arguments.
+func (p *Pargo) CreateOpportunity(args CreateOpportunity) error {
+	if args.ProspectID <= 0 && args.ProspectEmail == "" {
+		return errors.New("missing prospect id or email")
+	}
+	body, err := p.do(context.Background(), args)
+	if err != nil {
+		return err
+	}
+	return readRecord(body, "opportunity", args.Placeholder)
+}
+
+func (CreateOpportunity) Method() string {
+	return http.MethodPost
+}
+
+func (q CreateOpportunity) Path() string {
+	if q.ProspectID > 0 {
+		return fmt.Sprintf("opportunity/%s/do/create/prospect_id/%d",
+			version, q.ProspectID)
+	}
+	return fmt.Sprintf("opportunity/%s/do/create/prospect_email/%s",
+		version, q.ProspectEmail)
+}
+
+func (q CreateOpportunity) Query() (map[string]string, error) {
+	query := map[string]string{
+		"name":        q.Name,
+		"value":       formatFloat(q.Value),
+		"probability": strconv.Itoa(q.Probability),
+	}
+	q.add(query)
+	return query, nil
+}
+
+// UpdateOpportunity is an endpoint to update an opportunity by id.
+// An empty Name and a nil Value or Probability are left unchanged.
+// The optional Placeholder receives the opportunity updated.
+type UpdateOpportunity struct {
+	ID          int
+	Name        string
+	Value       *float64
+	Probability *int
+	OpportunityFields
+	Placeholder *Opportunity
+}
+
+// UpdateOpportunity executes the endpoint with arguments.
+func (p *Pargo) UpdateOpportunity(args UpdateOpportunity) error {
+	body, err := p.do(context.Background(), args)
+	if err != nil {
+		return err
+	}
+	return readRecord(body, "opportunity", args.Placeholder)
+}
+
+func (UpdateOpportunity) Method() string {
+	return http.MethodPost
+}
+
+func (q UpdateOpportunity) Path() string {
+	return fmt.Sprintf("opportunity/%s/do/update/id/%d", version, q.ID)
+}
+
+func (q UpdateOpportunity) Query() (map[string]string, error) {
+	query := make(map[string]string)
+	addString(query, "name", q.Name)
+	if q.Value != nil {
+		query["value"] = formatFloat(*q.Value)
+	}
+	if q.Probability != nil {
+		query["probability"] = strconv.Itoa(*q.Probability)
+	}
+	q.add(query)
+	return query, nil
+}
+
+// DeleteOpportunity is an endpoint to delete an opportunity by id.
+type DeleteOpportunity struct {
+	ID int
+}
+
+// DeleteOpportunity executes the endpoint with arguments.
+func (p *Pargo) DeleteOpportunity(args DeleteOpportunity) error {
+	_, err := p.do(context.Background(), args)
+	return err
+}
+
+func (DeleteOpportunity) Method() string {
+	return http.MethodPost
+}
+
+func (q DeleteOpportunity) Path() string {
+	return fmt.Sprintf("opportunity/%s/do/delete/id/%d", version, q.ID)
+}
+
+// UndeleteOpportunity is an endpoint to restore a deleted opportunity by
+// id. The optional Placeholder receives the opportunity restored.
+type UndeleteOpportunity struct {
+	ID          int
+	Placeholder *Opportunity
+}
+
+// UndeleteOpportunity executes the endpoint with arguments.
+func (p *Pargo) UndeleteOpportunity(args UndeleteOpportunity) error {
+	body, err := p.do(context.Background(), args)
+	if err != nil {
+		return err
+	}
+	return readRecord(body, "opportunity", args.Placeholder)
+}
+
+func (UndeleteOpportunity) Method() string {
+	return http.MethodPost
+}
+
+func (q UndeleteOpportunity) Path() string {
+	return fmt.Sprintf("opportunity/%s/do/undelete/id/%d", version, q.ID)
+}
@@ -0,0 +1,118 @@
+package pargo_test
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/brunoflores/pargo"
+)
+
+func TestOpportunityCRUD(t *testing.T) {
+	var got *http.Request
+	client := newCannedClient(func(req *http.Request) string {
+		got = req
+		if strings.HasSuffix(req.URL.Path, "/query") {
+			return `{"result":{"total_results":2,"opportunity":[
+				{"id":7,"name":"Deal","value":1500.5,"probability":60},
+				{"id":8,"name":"Other","value":20,"probability":10}]}}`
+		}
+		return `{"opportunity":{"id":7,"name":"Deal","value":1500.5,
+			"probability":60,"status":"won","closed_at":"2020-03-01 12:00:00"}}`
+	})
+
+	var opportunities []pargo.Opportunity
+	err := client.QueryOpportunities(pargo.QueryOpportunities{
+		Limit:                  200,
+		ProbabilityGreaterThan: 50,
+		ValueLessThan:          2000.5,
+		Placeholder:            &opportunities,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.FormValue("probability_greater_than") != "50" ||
+		got.FormValue("value_less_than") != "2000.5" ||
+		got.FormValue("value_greater_than") != "" {
+		t.Errorf("query = %v", got.Form)
+	}
+	if len(opportunities) != 2 || opportunities[0].Value != 1500.5 {
+		t.Fatalf("got %+v", opportunities)
+	}
+
+	var opportunity pargo.Opportunity
+	zeroValue, zeroProbability := 0.0, 0
+	checkEndpointCalls(t, &got, []endpointCall{
+		{
+			func() error {
+				return client.ReadOpportunity(pargo.ReadOpportunity{
+					ID: 7, Placeholder: &opportunity})
+			},
+			"GET", "/api/opportunity/version/4/do/read/id/7", nil,
+		},
+		{
+			func() error {
+				return client.CreateOpportunity(pargo.CreateOpportunity{
+					ProspectEmail:     "a@b.com",
+					Name:              "Deal",
+					Value:             1500.5,
+					Probability:       60,
+					OpportunityFields: pargo.OpportunityFields{CampaignID: 4},
+				})
+			},
+			"POST", "/api/opportunity/version/4/do/create/prospect_email/a@b.com",
+			map[string]string{"name": "Deal", "value": "1500.5",
+				"probability": "60", "campaign_id": "4"},
+		},
+		{
+			func() error {
+				return client.CreateOpportunity(pargo.CreateOpportunity{
+					ProspectID: 3, Name: "Deal", Value: 10})
+			},
+			"POST", "/api/opportunity/version/4/do/create/prospect_id/3",
+			map[string]string{"name": "Deal", "value": "10", "probability": "0"},
+		},
+		{
+			func() error {
+				return client.UpdateOpportunity(pargo.UpdateOpportunity{
+					ID: 7,
+					OpportunityFields: pargo.OpportunityFields{
+						Status: pargo.OpportunityWon},
+				})
+			},
+			"POST", "/api/opportunity/version/4/do/update/id/7",
+			map[string]string{"status": "won"},
+		},
+		{
+			func() error {
+				return client.UpdateOpportunity(pargo.UpdateOpportunity{
+					ID: 7, Value: &zeroValue, Probability: &zeroProbability,
+					OpportunityFields: pargo.OpportunityFields{
+						Status: pargo.OpportunityLost},
+				})
+			},
+			"POST", "/api/opportunity/version/4/do/update/id/7",
+			map[string]string{"status": "lost", "value": "0", "probability": "0"},
+		},
+		{
+			func() error {
+				return client.DeleteOpportunity(pargo.DeleteOpportunity{ID: 7})
+			},
+			"POST", "/api/opportunity/version/4/do/delete/id/7", nil,
+		},
+		{
+			func() error {
+				return client.UndeleteOpportunity(pargo.UndeleteOpportunity{ID: 7})
+			},
+			"POST", "/api/opportunity/version/4/do/undelete/id/7", nil,
+		},
+	})
+	if opportunity.Status != pargo.OpportunityWon || opportunity.ClosedAt.Month() != 3 {
+		t.Fatalf("got %+v", opportunity)
+	}
+
+	err = client.CreateOpportunity(pargo.CreateOpportunity{})
+	if err == nil {
+		t.Fatal("expected an error without a prospect")
+	}
+}
@@ -0,0 +1,184 @@
+package pargo
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"iter"
+
+	"github.com/pkg/errors"
+)
+
+// Pager walks through every page of a query endpoint, one page at a
+// time, decoding each record as a T.
+//
+// Pardot has no uniform way to tell the last page: the key holding the
+// records may be missing, hold an empty array, or hold a single object
+// rather than an array. Pager reads all of them the same way and stops
+// after the first page with fewer records than the limit.
+type Pager[T any] struct {
+	client *Pargo
+	key    string
+	limit  int
+	page   func(offset, limit int) Endpoint
+
+	// id is set for keyset paging, where the cursor passed to page is
+	// the greatest id seen rather than an offset.
+	id func(T) int
+}
+
+// NewPager returns a Pager calling the endpoint built by page for each
+// offset, reading the records under key in the result.
+// A limit of zero or less defaults to 200, the most Pardot returns.
+func NewPager[T any](
+	p *Pargo,
+	key string,
+	limit int,
+	page func(offset, limit int) Endpoint,
+) *Pager[T] {
+	if limit <= 0 || limit > maxPageSize {
+		limit = maxPageSize
+	}
+	return &Pager[T]{client: p, key: key, limit: limit, page: page}
+}
+
+// NewKeysetPager returns a Pager calling the endpoint built by page with
+// the greatest id seen so far, starting from zero, rather than with an
+// offset. The endpoint must sort by ascending id and only return records
+// with an id greater than after.
+// Unlike offsets, records created or deleted while paging do not shift
+// the pages, so every record is visited exactly once.
+func NewKeysetPager[T any](
+	p *Pargo,
+	key string,
+	limit int,
+	page func(after, limit int) Endpoint,
+	id func(T) int,
+) *Pager[T] {
+	pg := NewPager[T](p, key, limit, page)
+	pg.id = id
+	return pg
+}
+
+// All returns an iterator over every record.
+// Iteration stops after the first error, which is yielded with the zero
+// T, including the error of ctx once it is done.
+func (pg *Pager[T]) All(ctx context.Context) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		for cursor := 0; ; {
+			if err := ctx.Err(); err != nil {
+				yield(zero, err)
+				return
+			}
+			records, err := pg.Page(ctx, cursor)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+			for _, r := range records {
+				if !yield(r, nil) {
+					return
+				}
+				if pg.id != nil && pg.id(r) > cursor {
+					cursor = pg.id(r)
+				}
+			}
+			if len(records) < pg.limit {
+				return
+			}
+			if pg.id == nil {
+				cursor += pg.limit
+			}
+		}
+	}
+}
+
+// Page requests the page at cursor, an offset or with keyset paging the
+// id to start after, and returns its records.
+// An empty page returns no records and no error.
+func (pg *Pager[T]) Page(ctx context.Context, cursor int) ([]T, error) {
+	body, err := pg.client.do(ctx, pg.page(cursor, pg.limit))
+	if err != nil {
+		return nil, err
+	}
+	return readRecords[T](body, pg.key)
+}
+
+// readRecords returns the records under key in the result of a query.
+func readRecords[T any](body []byte, key string) ([]T, error) {
+	var res struct {
+		Result map[string]json.RawMessage `json:"result"`
+	}
+	if err := json.Unmarshal(body, &res); err != nil {
+		return nil, errors.Wrap(err, "got invalid JSON from Pardot")
+	}
+	return decodeRecords[T](res.Result[key])
+}
+
+// decodeRecords reads either an array of records or, as Pardot returns
+// when there is only one, a single record.
+func decodeRecords[T any](raw json.RawMessage) ([]T, error) {
+	raw = bytes.TrimSpace(raw)
+	if len(raw) == 0 || bytes.Equal(raw, []byte("null")) {
+		return nil, nil
+	}
+	if raw[0] != '[' {
+		var one T
+		if err := json.Unmarshal(raw, &one); err != nil {
+			return nil, errors.Wrap(err, "unmarshaling record")
+		}
+		return []T{one}, nil
+	}
+	var many []T
+	if err := json.Unmarshal(raw, &many); err != nil {
+		return nil, errors.Wrap(err, "unmarshaling records")
+	}
+	return many, nil
+}
+
+// QueryProspectsPager returns a Pager over the prospects matching q,
+// each decoded as a T. The offset of q is ignored and its limit is the
+// page size.
+func QueryProspectsPager[T any](p *Pargo, q QueryProspects) *Pager[T] {
+	return NewPager[T](p, "prospect", q.Limit,
+		func(offset, limit int) Endpoint {
+			q.Offset, q.Limit = offset, limit
+			return q
+		})
+}
+
+// ListMembershipsPager returns a Pager over the memberships of the list
+// in q. The offset of q is ignored and its limit is the page size.
+func (p *Pargo) ListMembershipsPager(
+	q ListMemberships,
+) *Pager[ListMembership] {
+	return NewPager[ListMembership](p, "list_membership", q.Limit,
+		func(offset, limit int) Endpoint {
+			q.Offset, q.Limit = offset, limit
+			return q
+		})
+}
+
+// pageAll calls fn with every page of records, serially, until a page
+// is shorter than the limit.
+func pageAll[T any](ctx context.Context, pg *Pager[T], fn func([]T)) error {
+	if fn == nil {
+		return errors.New("missing Page callback")
+	}
+	page := make([]T, 0, pg.limit)
+	for r, err := range pg.All(ctx) {
+		if err != nil {
+			return err
+		}
+		page = append(page, r)
+		if len(page) == pg.limit {
+			fn(page)
+			page = make([]T, 0, pg.limit)
+		}
+	}
+	if len(page) > 0 {
+		fn(page)
+	}
+	return nil
+}
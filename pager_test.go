@@ -0,0 +1,148 @@
+package pargo_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/brunoflores/pargo"
+)
+
+// newMembershipsServer serves count memberships of list 7, answering a
+// page with a single membership as an object as Pardot does.
+func newMembershipsServer(t *testing.T, count int) *http.Client {
+	return newTestHTTPClient(func(req *http.Request) *http.Response {
+		u := req.URL.Path
+		switch {
+		case strings.Contains(u, `oauth2/`):
+			return &http.Response{
+				StatusCode: 200,
+				Body:       ioutil.NopCloser(bytes.NewBufferString(`{}`)),
+				Header:     make(http.Header)}
+		case strings.Contains(u, `listMembership/`):
+			offset, _ := strconv.Atoi(req.FormValue("offset"))
+			limit, _ := strconv.Atoi(req.FormValue("limit"))
+			var page []string
+			for i := offset; i < count && i < offset+limit; i++ {
+				page = append(page, fmt.Sprintf(
+					`{"list_id":7,"prospect_id":%d}`, i+1))
+			}
+			var bodyStr string
+			switch len(page) {
+			case 0:
+				bodyStr = `{"result":{"total_results":0}}`
+			case 1:
+				bodyStr = `{"result":{"list_membership":` + page[0] + `}}`
+			default:
+				bodyStr = `{"result":{"list_membership":[` +
+					strings.Join(page, ",") + `]}}`
+			}
+			return &http.Response{
+				StatusCode: 200,
+				Body:       ioutil.NopCloser(bytes.NewBufferString(bodyStr)),
+				Header:     make(http.Header)}
+		default:
+			t.Errorf("unknown endpoint called %q", u)
+			return nil
+		}
+	})
+}
+
+func TestPagerListMemberships(t *testing.T) {
+	for _, count := range []int{0, 1, 10, 21} {
+		client := newTestClient(newMembershipsServer(t, count))
+		pager := client.ListMembershipsPager(pargo.ListMemberships{
+			ListID: 7,
+			Limit:  10,
+		})
+		var got int
+		for m, err := range pager.All(context.Background()) {
+			if err != nil {
+				t.Fatal(err)
+			}
+			got++
+			if m.ProspectID != got {
+				t.Fatalf("got prospect %d; want %d", m.ProspectID, got)
+			}
+		}
+		if got != count {
+			t.Fatalf("got %d memberships; want %d", got, count)
+		}
+	}
+}
+
+func TestPagerProspects(t *testing.T) {
+	var ids []int
+	for i := 1; i <= 15; i++ {
+		ids = append(ids, i)
+	}
+	client := newTestClient(newProspectsServer(t, testProspects(ids), nil))
+	pager := pargo.QueryProspectsPager[testProspect](client,
+		pargo.QueryProspects{Fields: []string{"id"}, Limit: 5})
+	var got []int
+	for p, err := range pager.All(context.Background()) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, p.ID)
+		if len(got) == 12 {
+			break
+		}
+	}
+	if len(got) != 12 || got[11] != 12 {
+		t.Fatalf("got %v; want the first 12 prospects", got)
+	}
+}
+
+func TestPagerStopsOnCancel(t *testing.T) {
+	client := newTestClient(newMembershipsServer(t, 100))
+	pager := client.ListMembershipsPager(pargo.ListMemberships{
+		ListID: 7,
+		Limit:  10,
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var got int
+	var last error
+	for _, err := range pager.All(ctx) {
+		if err != nil {
+			last = err
+			continue
+		}
+		got++
+		if got == 15 {
+			cancel()
+		}
+	}
+	if got != 20 {
+		t.Fatalf("got %d memberships; want the 20 of two pages", got)
+	}
+	if last != context.Canceled {
+		t.Fatalf("got error %v; want %v", last, context.Canceled)
+	}
+}
+
+func TestPagerStopsOnError(t *testing.T) {
+	client := newTestClient(newTestHTTPClient(func(req *http.Request) *http.Response {
+		return &http.Response{
+			StatusCode: 503,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(`{}`)),
+			Header:     make(http.Header)}
+	}))
+	pager := client.ListMembershipsPager(pargo.ListMemberships{ListID: 7})
+	var errs int
+	for _, err := range pager.All(context.Background()) {
+		if err == nil {
+			t.Fatal("want error; got nil")
+		}
+		errs++
+	}
+	if errs != 1 {
+		t.Fatalf("got %d errors; want 1", errs)
+	}
+}
@@ -2,12 +2,15 @@
 package pargo
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"net/http"
 	"net/url"
+	"reflect"
 	"strings"
 
 	"sync"
@@ -37,6 +40,18 @@ func (e ErrInvalidJSON) Error() string {
 	return e.msg
 }
 
+// ErrAPI is any other error returned by Pardot.
+// It implements `error`.
+// See http://developer.pardot.com/kb/error-codes-messages.
+type ErrAPI struct {
+	Code int
+	msg  string
+}
+
+func (e ErrAPI) Error() string {
+	return e.msg
+}
+
 const (
 	hostSalesforce = "apnic.my.salesforce.com"
 	base           = "pi.pardot.com"
@@ -55,6 +70,9 @@ type Pargo struct {
 	apiKeyMu sync.Mutex
 
 	businessUnitId string // Introduced after SSO migration to Salesforce.
+
+	// limiter bounds the requests in flight to its capacity.
+	limiter chan struct{}
 }
 
 // UserAccount is the set of required credentials.
@@ -71,6 +89,7 @@ func NewPargo(u UserAccount, businessUnitId string, confs ...func(*Pargo)) *Parg
 		client:         &http.Client{}, // Default client.
 		user:           u,
 		businessUnitId: businessUnitId,
+		limiter:        make(chan struct{}, maxWorkers),
 	}
 	for _, conf := range confs {
 		conf(&client)
@@ -85,6 +104,16 @@ func WithCustomClient(c *http.Client) func(*Pargo) {
 	}
 }
 
+// WithConcurrency sets how many requests may be in flight at once.
+// It defaults to 5, the most Pardot allows.
+func WithConcurrency(n int) func(*Pargo) {
+	return func(client *Pargo) {
+		if n > 0 {
+			client.limiter = make(chan struct{}, n)
+		}
+	}
+}
+
 // Endpoint is the behaviour required for an endpoint.
 type Endpoint interface {
 	Method() string
@@ -110,14 +139,9 @@ func (p *Pargo) Call(req *http.Request) ([]byte, error) {
 
 	req.Header = p.addAuthHeaders(req.Header)
 
-	res, err := p.client.Do(req)
-	if err != nil {
-		return nil, errors.Wrap(err, "issuing request")
-	}
-	defer res.Body.Close()
-	resBytes, err := ioutil.ReadAll(res.Body)
+	res, resBytes, err := p.send(req)
 	if err != nil {
-		return nil, errors.Wrap(err, "reading response bytes")
+		return nil, err
 	}
 	switch c := res.StatusCode; c {
 	case 200, 201, 204:
@@ -137,7 +161,66 @@ func (p *Pargo) Call(req *http.Request) ([]byte, error) {
 	return resBytes, nil
 }
 
+// do builds the request for an endpoint, bound to ctx, and calls it.
+func (p *Pargo) do(ctx context.Context, e Endpoint) ([]byte, error) {
+	req, err := p.NewRequest(e, make(http.Header))
+	if err != nil {
+		return nil, errors.Wrap(err, "building request")
+	}
+	body, err := p.Call(req.WithContext(ctx))
+	if err != nil {
+		return nil, errors.Wrap(err, "requesting")
+	}
+	return body, nil
+}
+
+// readRecord unmarshals the record under key at the top level of a
+// response, as returned by read, create and update endpoints.
+// A nil placeholder skips reading.
+func readRecord(res []byte, key string, placeholder interface{}) error {
+	v := reflect.ValueOf(placeholder)
+	if !v.IsValid() || (v.Kind() == reflect.Ptr && v.IsNil()) {
+		return nil
+	}
+	body := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(res, &body); err != nil {
+		return errors.Wrap(err, "got invalid JSON from Pardot")
+	}
+	raw, ok := body[key]
+	if !ok {
+		return errors.Errorf("missing %q in response", key)
+	}
+	if err := json.Unmarshal(raw, placeholder); err != nil {
+		return errors.Wrapf(err, "unmarshaling %s", key)
+	}
+	return nil
+}
+
+// send issues the request once a slot in the limiter is free, and reads
+// the whole response.
+func (p *Pargo) send(req *http.Request) (*http.Response, []byte, error) {
+	if p.limiter != nil {
+		p.limiter <- struct{}{}
+		defer func() { <-p.limiter }()
+	}
+
+	res, err := p.client.Do(req)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "issuing request")
+	}
+	defer res.Body.Close()
+	resBytes, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "reading response bytes")
+	}
+	return res, resBytes, nil
+}
+
 func (p *Pargo) parseRes(resBytes []byte, req *http.Request) ([]byte, error) {
+	if len(bytes.TrimSpace(resBytes)) == 0 {
+		// No content, as from deletes.
+		return resBytes, nil
+	}
 	resBody := struct {
 		Err  *string `json:"err,omitempty"`
 		Attr *struct {
@@ -149,17 +232,29 @@ func (p *Pargo) parseRes(resBytes []byte, req *http.Request) ([]byte, error) {
 		return nil, errors.Wrap(err, "unmarshaling response")
 	}
 	if resBody.Err != nil {
+		if resBody.Attr == nil {
+			return nil, ErrAPI{msg: *resBody.Err}
+		}
 		switch resBody.Attr.ErrCode {
 		case 1:
 			// API key expired so refresh key and try again with
 			// the same body.
 			p.apiKey = ""
 			p.maybeAuth()
+			if req.GetBody != nil {
+				body, err := req.GetBody()
+				if err != nil {
+					return nil, errors.Wrap(err, "rewinding body")
+				}
+				req.Body = body
+			}
 			return p.Call(req)
 		case 15:
 			return nil, ErrLoginFailed{*resBody.Err}
 		case 71:
 			return nil, ErrInvalidJSON{*resBody.Err}
+		default:
+			return nil, ErrAPI{resBody.Attr.ErrCode, *resBody.Err}
 		}
 	}
 	return resBytes, nil
@@ -186,7 +281,18 @@ func (p *Pargo) NewRequest(
 		if err != nil {
 			return nil, err
 		}
-		req.Body = body
+		// The body is kept so that it can be sent again after the api
+		// key is refreshed.
+		b, err := ioutil.ReadAll(body)
+		body.Close()
+		if err != nil {
+			return nil, errors.Wrap(err, "reading body")
+		}
+		req.GetBody = func() (io.ReadCloser, error) {
+			return ioutil.NopCloser(bytes.NewReader(b)), nil
+		}
+		req.Body, _ = req.GetBody()
+		req.ContentLength = int64(len(b))
 	}
 
 	q := req.URL.Query()
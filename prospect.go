@@ -0,0 +1,27 @@
+package pargo
+
+// Prospect has the standard fields of a prospect, for callers who do not
+// need their own type. Fields not requested are left empty.
+//
+// Pardot returns the campaign of a prospect either nested, when reading
+// a prospect, or as CampaignID alone, when querying; CampaignNames can
+// fill in the rest.
+type Prospect struct {
+	ID           int       `json:"id"`
+	Email        string    `json:"email"`
+	FirstName    string    `json:"first_name"`
+	LastName     string    `json:"last_name"`
+	Company      string    `json:"company"`
+	Score        int       `json:"score"`
+	Grade        string    `json:"grade"`
+	OptedOut     Bool      `json:"opted_out"`
+	IsDoNotEmail Bool      `json:"is_do_not_email"`
+	CampaignID   int       `json:"campaign_id,omitempty"`
+	Campaign     *Campaign `json:"campaign,omitempty"`
+	CreatedAt    Time      `json:"created_at"`
+	UpdatedAt    Time      `json:"updated_at"`
+
+	// Custom has the values of custom fields, when decoded with a
+	// ProspectSchema.
+	Custom map[string]interface{} `json:"-"`
+}
@@ -0,0 +1,287 @@
+package pargo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// ProspectAccount is a company grouping prospects, for accounts not
+// synced to a CRM.
+type ProspectAccount struct {
+	ID            int    `json:"id"`
+	Name          string `json:"name"`
+	Number        string `json:"number"`
+	Description   string `json:"description"`
+	Phone         string `json:"phone"`
+	Fax           string `json:"fax"`
+	Website       string `json:"website"`
+	Rating        string `json:"rating"`
+	Site          string `json:"site"`
+	Type          string `json:"type"`
+	AnnualRevenue string `json:"annual_revenue"`
+	Industry      string `json:"industry"`
+	Employees     string `json:"employees"`
+	Ownership     string `json:"ownership"`
+	TickerSymbol  string `json:"ticker_symbol"`
+	CreatedAt     Time   `json:"created_at"`
+	UpdatedAt     Time   `json:"updated_at"`
+
+	// Custom has the values of the other fields, by API name, such as
+	// given by DescribeProspectAccount. Values are strings, or []string
+	// for fields with several values. Empty values are left out.
+	Custom map[string]interface{} `json:"-"`
+}
+
+// prospectAccountFields are the fields of ProspectAccount, and other
+// fields that are not custom.
+var prospectAccountFields = func() map[string]bool {
+	fields := map[string]bool{"assigned_to": true}
+	t := reflect.TypeOf(ProspectAccount{})
+	for i := 0; i < t.NumField(); i++ {
+		name := strings.Split(t.Field(i).Tag.Get("json"), ",")[0]
+		if name != "-" {
+			fields[name] = true
+		}
+	}
+	return fields
+}()
+
+func (a *ProspectAccount) UnmarshalJSON(b []byte) error {
+	type account ProspectAccount
+	if err := json.Unmarshal(b, (*account)(a)); err != nil {
+		return err
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(b, &fields); err != nil {
+		return err
+	}
+	for name, raw := range fields {
+		if prospectAccountFields[name] {
+			continue
+		}
+		values, err := customValues(raw)
+		if err != nil {
+			return errors.Wrapf(err, "reading custom field %s", name)
+		}
+		if len(values) == 0 {
+			continue
+		}
+		if a.Custom == nil {
+			a.Custom = make(map[string]interface{})
+		}
+		if len(values) == 1 {
+			a.Custom[name] = values[0]
+		} else {
+			a.Custom[name] = values
+		}
+	}
+	return nil
+}
+
+// QueryProspectAccounts is an endpoint to query a page of prospect
+// accounts.
+type QueryProspectAccounts struct {
+	Offset, Limit int
+	QueryFilters
+
+	Placeholder *[]ProspectAccount
+}
+
+// QueryProspectAccounts executes the endpoint with arguments.
+func (p *Pargo) QueryProspectAccounts(args QueryProspectAccounts) error {
+	body, err := p.do(context.Background(), args)
+	if err != nil {
+		return err
+	}
+	accounts, err := readRecords[ProspectAccount](body, "prospectAccount")
+	if err != nil {
+		return err
+	}
+	*args.Placeholder = append(*args.Placeholder, accounts...)
+	return nil
+}
+
+func (QueryProspectAccounts) Method() string {
+	return http.MethodGet
+}
+
+func (QueryProspectAccounts) Path() string {
+	return "prospectAccount/" + version + "/do/query"
+}
+
+func (q QueryProspectAccounts) Query() (map[string]string, error) {
+	return q.pageQuery(q.Offset, q.Limit), nil
+}
+
+// QueryProspectAccountsPager returns a Pager over every prospect account
+// matching q. The offset of q is ignored and its limit is the page size.
+func (p *Pargo) QueryProspectAccountsPager(
+	q QueryProspectAccounts,
+) *Pager[ProspectAccount] {
+	return NewPager[ProspectAccount](p, "prospectAccount", q.Limit,
+		func(offset, limit int) Endpoint {
+			q.Offset, q.Limit = offset, limit
+			return q
+		})
+}
+
+// ReadProspectAccount is an endpoint to read a prospect account by id.
+type ReadProspectAccount struct {
+	ID          int
+	Placeholder *ProspectAccount
+}
+
+// ReadProspectAccount executes the endpoint with arguments.
+func (p *Pargo) ReadProspectAccount(args ReadProspectAccount) error {
+	body, err := p.do(context.Background(), args)
+	if err != nil {
+		return err
+	}
+	return readRecord(body, "prospectAccount", args.Placeholder)
+}
+
+func (ReadProspectAccount) Method() string {
+	return http.MethodGet
+}
+
+func (q ReadProspectAccount) Path() string {
+	return fmt.Sprintf("prospectAccount/%s/do/read/id/%d", version, q.ID)
+}
+
+// CreateProspectAccount is an endpoint to create a prospect account.
+// Fields are set by API name, standard or custom, and must include
+// "name". The optional Placeholder receives the account created.
+type CreateProspectAccount struct {
+	Fields      map[string]string
+	Placeholder *ProspectAccount
+}
+
+// CreateProspectAccount executes the endpoint with arguments.
+func (p *Pargo) CreateProspectAccount(args CreateProspectAccount) error {
+	if args.Fields["name"] == "" {
+		return errors.New("missing name")
+	}
+	body, err := p.do(context.Background(), args)
+	if err != nil {
+		return err
+	}
+	return readRecord(body, "prospectAccount", args.Placeholder)
+}
+
+func (CreateProspectAccount) Method() string {
+	return http.MethodPost
+}
+
+func (CreateProspectAccount) Path() string {
+	return "prospectAccount/" + version + "/do/create"
+}
+
+func (q CreateProspectAccount) Query() (map[string]string, error) {
+	return copyFields(q.Fields), nil
+}
+
+// UpdateProspectAccount is an endpoint to update a prospect account by
+// id. Fields are set by API name, standard or custom; an empty value
+// clears the field. The optional Placeholder receives the account
+// updated.
+type UpdateProspectAccount struct {
+	ID          int
+	Fields      map[string]string
+	Placeholder *ProspectAccount
+}
+
+// UpdateProspectAccount executes the endpoint with arguments.
+func (p *Pargo) UpdateProspectAccount(args UpdateProspectAccount) error {
+	body, err := p.do(context.Background(), args)
+	if err != nil {
+		return err
+	}
+	return readRecord(body, "prospectAccount", args.Placeholder)
+}
+
+func (UpdateProspectAccount) Method() string {
+	return http.MethodPost
+}
+
+func (q UpdateProspectAccount) Path() string {
+	return fmt.Sprintf("prospectAccount/%s/do/update/id/%d", version, q.ID)
+}
+
+func (q UpdateProspectAccount) Query() (map[string]string, error) {
+	return copyFields(q.Fields), nil
+}
+
+// copyFields copies fields so that the request does not share them.
+func copyFields(fields map[string]string) map[string]string {
+	query := make(map[string]string, len(fields))
+	for k, v := range fields {
+		query[k] = v
+	}
+	return query
+}
+
+// AccountField is a field of prospect accounts, as described by Pardot.
+type AccountField struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	Type     string `json:"type"`
+	Required Bool   `json:"required"`
+	IsCustom Bool   `json:"is_custom"`
+}
+
+func (f *AccountField) UnmarshalJSON(b []byte) error {
+	// Fields may come with their properties under "@attributes".
+	type field AccountField
+	var v struct {
+		field
+		Attributes *field `json:"@attributes"`
+	}
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	*f = AccountField(v.field)
+	if v.Attributes != nil {
+		*f = AccountField(*v.Attributes)
+	}
+	return nil
+}
+
+// DescribeProspectAccount is an endpoint to read the fields of prospect
+// accounts, standard and custom.
+type DescribeProspectAccount struct {
+	Placeholder *[]AccountField
+}
+
+// DescribeProspectAccount executes the endpoint with arguments.
+func (p *Pargo) DescribeProspectAccount(args DescribeProspectAccount) error {
+	body, err := p.do(context.Background(), args)
+	if err != nil {
+		return err
+	}
+	var result struct {
+		Field json.RawMessage `json:"field"`
+	}
+	if err := readRecord(body, "result", &result); err != nil {
+		return err
+	}
+	fields, err := decodeRecords[AccountField](result.Field)
+	if err != nil {
+		return err
+	}
+	*args.Placeholder = append(*args.Placeholder, fields...)
+	return nil
+}
+
+func (DescribeProspectAccount) Method() string {
+	return http.MethodGet
+}
+
+func (DescribeProspectAccount) Path() string {
+	return "prospectAccount/" + version + "/do/describe"
+}
@@ -0,0 +1,99 @@
+package pargo_test
+
+import (
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/brunoflores/pargo"
+)
+
+func TestProspectAccountCRUD(t *testing.T) {
+	var got *http.Request
+	client := newCannedClient(func(req *http.Request) string {
+		got = req
+		if strings.HasSuffix(req.URL.Path, "/query") {
+			return `{"result":{"total_results":1,"prospectAccount":
+				{"id":5,"name":"Acme"}}}`
+		}
+		return `{"prospectAccount":{"id":5,"name":"Acme","website":"acme.com",
+			"assigned_to":{"user":{"id":3}},"region":"EMEA","tier":"",
+			"products":{"value":["A","B"]},"seats":120}}`
+	})
+
+	var accounts []pargo.ProspectAccount
+	err := client.QueryProspectAccounts(pargo.QueryProspectAccounts{
+		Limit: 200, Placeholder: &accounts})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(accounts) != 1 || accounts[0].Name != "Acme" || accounts[0].Custom != nil {
+		t.Fatalf("got %+v", accounts)
+	}
+
+	var account pargo.ProspectAccount
+	checkEndpointCalls(t, &got, []endpointCall{
+		{
+			func() error {
+				return client.ReadProspectAccount(pargo.ReadProspectAccount{
+					ID: 5, Placeholder: &account})
+			},
+			"GET", "/api/prospectAccount/version/4/do/read/id/5", nil,
+		},
+		{
+			func() error {
+				return client.CreateProspectAccount(pargo.CreateProspectAccount{
+					Fields: map[string]string{"name": "Acme", "region": "EMEA"}})
+			},
+			"POST", "/api/prospectAccount/version/4/do/create",
+			map[string]string{"name": "Acme", "region": "EMEA"},
+		},
+		{
+			func() error {
+				return client.UpdateProspectAccount(pargo.UpdateProspectAccount{
+					ID: 5, Fields: map[string]string{"website": "acme.com"}})
+			},
+			"POST", "/api/prospectAccount/version/4/do/update/id/5",
+			map[string]string{"website": "acme.com"},
+		},
+	})
+	want := map[string]interface{}{
+		"region": "EMEA", "products": []string{"A", "B"}, "seats": "120"}
+	if account.Website != "acme.com" || !reflect.DeepEqual(account.Custom, want) {
+		t.Fatalf("got %+v", account)
+	}
+
+	err = client.CreateProspectAccount(pargo.CreateProspectAccount{
+		Fields: map[string]string{"website": "acme.com"}})
+	if err == nil {
+		t.Fatal("expected an error without a name")
+	}
+}
+
+func TestDescribeProspectAccount(t *testing.T) {
+	var got *http.Request
+	client := newCannedClient(func(req *http.Request) string {
+		got = req
+		return `{"result":{"field":[
+			{"id":"name","name":"Name","type":"text","required":"true"},
+			{"@attributes":{"id":"region","name":"Region","type":"dropdown",
+				"required":"false","is_custom":"true"}}]}}`
+	})
+
+	var fields []pargo.AccountField
+	err := client.DescribeProspectAccount(pargo.DescribeProspectAccount{Placeholder: &fields})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.URL.Path != "/api/prospectAccount/version/4/do/describe" {
+		t.Fatalf("got %s", got.URL.Path)
+	}
+	want := []pargo.AccountField{
+		{ID: "name", Name: "Name", Type: "text", Required: true},
+		{ID: "region", Name: "Region", Type: "dropdown", IsCustom: true},
+	}
+	if !reflect.DeepEqual(fields, want) {
+		t.Fatalf("got %+v; want %+v", fields, want)
+	}
+}
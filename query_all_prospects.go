@@ -3,104 +3,407 @@ package pargo
 import (
 	"encoding/json"
 	"sync"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// The Pardot REST API allows at most 5 parallel requests.
+	// Here we are making 4 to be on the safe side.
+	defaultWorkers = 4
+	maxWorkers     = 5
+
+	// Pardot returns at most 200 records per page.
+	maxPageSize = 200
+)
+
+// Paging is the strategy used to walk through all pages of a query.
+type Paging int
+
+const (
+	// PagingOffset requests pages by offset, in parallel.
+	// Pardot gets slow on large offsets, and prospects created or
+	// deleted during the walk shift the pages, so records may be skipped
+	// or seen twice.
+	PagingOffset Paging = iota
+
+	// PagingKeyset requests one page at a time sorted by ascending id,
+	// each page starting after the greatest id of the previous one.
+	// Every prospect is visited exactly once.
+	PagingKeyset
+
+	// PagingKeysetRanges splits the id space into ranges, one per
+	// worker, and walks each range as in PagingKeyset.
+	PagingKeysetRanges
 )
 
+// PageInfo is where a page delivered by QueryAllProspects sits in the
+// walk, so progress can be recorded.
+type PageInfo struct {
+	// Number is the zero-based position of the page. With
+	// PagingKeysetRanges it is the position within its id range.
+	Number int
+
+	// Offset and Limit of the request, with PagingOffset.
+	Offset, Limit int
+
+	// After is the id the page starts after, with keyset paging.
+	After int
+}
+
 type QueryAllProspects struct {
-	Fields    []string
+	Fields []string
+
+	// Either Page or PageAt is required. They are called with the JSON
+	// of each page, PageAt also with where the page sits in the walk.
 	Page      func(json.RawMessage)
+	PageAt    func(json.RawMessage, PageInfo)
 	Heartbeat func(offset, limit int)
+
+	// Paging defaults to PagingOffset.
+	// With keyset paging, Heartbeat receives the id the page starts
+	// after in place of the offset.
+	Paging Paging
+
+	// Workers is the number of pages requested in parallel.
+	// It defaults to 4 and cannot be more than 5, the limit of
+	// concurrent requests in Pardot.
+	Workers int
+
+	// PageSize is the number of prospects per page.
+	// It defaults to 200 and cannot be more than that, the most Pardot
+	// returns.
+	PageSize int
+
+	// Ordered calls Page or PageAt serially and in page order.
+	// Pages fetched ahead of a slower one are held back, at most
+	// ReorderBuffer of them, which defaults to twice the workers.
+	// It is not supported with PagingKeysetRanges, and PagingKeyset is
+	// always ordered.
+	Ordered       bool
+	ReorderBuffer int
+}
+
+func (q QueryAllProspects) workers() int {
+	if q.Workers > 0 {
+		return q.Workers
+	}
+	return defaultWorkers
+}
+
+func (q QueryAllProspects) reorderBuffer() int {
+	if q.ReorderBuffer > 0 {
+		return q.ReorderBuffer
+	}
+	return 2 * q.workers()
+}
+
+func (q QueryAllProspects) emit(page json.RawMessage, info PageInfo) {
+	if q.PageAt != nil {
+		q.PageAt(page, info)
+		return
+	}
+	q.Page(page)
+}
+
+func (q QueryAllProspects) pageSize() int {
+	if q.PageSize > 0 {
+		return q.PageSize
+	}
+	return maxPageSize
 }
 
 // QueryAllProspects will return a slice of all *prospects* in Pardot.
 // The order in which prospects are returned is not guaranteed.
+//
+// It returns once every worker has stopped: after the first empty page,
+// or after the first error, which is then returned.
+// Unless Ordered, Page may be called concurrently from several workers.
 func (p *Pargo) QueryAllProspects(query QueryAllProspects) error {
+	if query.Page == nil && query.PageAt == nil {
+		return errors.New("missing Page callback")
+	}
+	if query.Ordered && query.Paging == PagingKeysetRanges {
+		return errors.New("ordered delivery with id ranges")
+	}
+	if query.Workers > maxWorkers {
+		return errors.Errorf("more than %d workers", maxWorkers)
+	}
+	if query.PageSize > maxPageSize {
+		return errors.Errorf("page size more than %d", maxPageSize)
+	}
+	switch query.Paging {
+	case PagingKeyset:
+		return p.queryProspectsByID(query, nil, 0, 0)
+	case PagingKeysetRanges:
+		return p.queryProspectsByIDRanges(query)
+	}
 
-	// The Pardot REST API allows at most 5 parallel requests.
-	// Here we are making 4 to be on the safe side.
-	const workers = 4
-
-	var done = make(chan struct{}, workers)
-	var quit = make(chan error, workers)
-	var err error
-	var jobs = make(chan int)
-	var shut = make(chan struct{}, workers)
-	var wg sync.WaitGroup
-
-	maybeLog := func(fn func(int, int), offset, limit int) {
-		if fn == nil {
-			return
+	g := newGroup()
+	limit := query.pageSize()
+
+	// The first empty page found. Pages before it may still be in
+	// flight and must be read; pages after it are empty too.
+	var (
+		eofMu sync.Mutex
+		eof   = -1
+	)
+	skip := func(n int) bool {
+		if g.failed() {
+			return true
 		}
-		fn(offset, limit)
-	}
-
-	queryWorker := func(
-		jobs <-chan int,
-		done chan<- struct{},
-		quit chan<- error) {
-
-		defer func() { done <- struct{}{} }()
-		for n := range jobs {
-			var (
-				limit  = 200
-				offset = n * limit
-			)
-			maybeLog(query.Heartbeat, offset, limit)
-			err := p.QueryProspects(QueryProspects{
-				Offset:    offset,
-				Limit:     limit,
-				Fields:    query.Fields,
-				Marshaler: query.Page,
-			})
-			if err != nil {
-				switch err.(type) {
-				case QueryProspectsEOF:
-					return
-				default:
-					quit <- err
-					return
+		eofMu.Lock()
+		defer eofMu.Unlock()
+		return eof >= 0 && n > eof
+	}
+
+	// With ordered delivery, workers hand their pages to a single
+	// goroutine which calls Page in order. A token is taken for each
+	// page requested and given back once it is delivered, bounding how
+	// many pages wait for a slower one.
+	type fetched struct {
+		page json.RawMessage
+		info PageInfo
+	}
+	var (
+		results chan fetched
+		tokens  chan struct{}
+		working sync.WaitGroup
+	)
+	if query.Ordered {
+		results = make(chan fetched)
+		tokens = make(chan struct{}, query.reorderBuffer())
+		g.Go(func() error {
+			pending := make(map[int]fetched)
+			next := 0
+			for r := range results {
+				pending[r.info.Number] = r
+				for {
+					f, ok := pending[next]
+					if !ok {
+						break
+					}
+					delete(pending, next)
+					query.emit(f.page, f.info)
+					<-tokens
+					next++
 				}
 			}
-		}
+			return nil
+		})
 	}
 
-	for i := 0; i < workers; i++ {
-		go queryWorker(jobs, done, quit)
+	// Jobs are page numbers. The channel is unbuffered so at most one
+	// page per worker is in flight.
+	jobs := make(chan int)
+	g.Go(func() error {
+		defer close(jobs)
+		for n := 0; ; n++ {
+			if g.stopped() {
+				return nil
+			}
+			if tokens != nil {
+				select {
+				case tokens <- struct{}{}:
+				case <-g.done():
+					return nil
+				}
+			}
+			select {
+			case jobs <- n:
+			case <-g.done():
+				return nil
+			}
+		}
+	})
+
+	for i := 0; i < query.workers(); i++ {
+		working.Add(1)
+		g.Go(func() error {
+			defer working.Done()
+			for n := range jobs {
+				// The producer may have handed out a page just as the
+				// group stopped.
+				if skip(n) {
+					return nil
+				}
+				offset := n * limit
+				if query.Heartbeat != nil {
+					query.Heartbeat(offset, limit)
+				}
+				info := PageInfo{Number: n, Offset: offset, Limit: limit}
+				err := p.QueryProspects(QueryProspects{
+					Offset: offset,
+					Limit:  limit,
+					Fields: query.Fields,
+					Marshaler: func(page json.RawMessage) {
+						if results != nil {
+							results <- fetched{page, info}
+							return
+						}
+						query.emit(page, info)
+					},
+				})
+				if err != nil {
+					if _, ok := err.(QueryProspectsEOF); ok {
+						eofMu.Lock()
+						if eof < 0 || n < eof {
+							eof = n
+						}
+						eofMu.Unlock()
+						g.stop()
+						return nil
+					}
+					return err
+				}
+			}
+			return nil
+		})
+	}
+	if results != nil {
+		go func() {
+			working.Wait()
+			close(results)
+		}()
 	}
 
-	go func() {
-		page := 0
-		for {
-			select {
-			case <-shut:
-				close(jobs)
-				close(done)
-			default:
-				jobs <- page
-				page++
+	return g.Wait()
+}
+
+// queryProspectsByID walks the prospects with ids in the open interval
+// (after, before), one page at a time. A zero before is unbounded.
+// It returns early once g, if any, is stopped.
+func (p *Pargo) queryProspectsByID(
+	query QueryAllProspects,
+	g *group,
+	after, before int,
+) error {
+	limit := query.pageSize()
+	fields := withFields(query.Fields, "id")
+	for number := 0; g == nil || !g.stopped(); number++ {
+		if query.Heartbeat != nil {
+			query.Heartbeat(after, limit)
+		}
+		var page json.RawMessage
+		err := p.QueryProspects(QueryProspects{
+			Limit:         limit,
+			Fields:        fields,
+			IDGreaterThan: after,
+			IDLessThan:    before,
+			SortBy:        "id",
+			SortOrder:     "ascending",
+			Marshaler:     func(r json.RawMessage) { page = r },
+		})
+		if err != nil {
+			if _, ok := err.(QueryProspectsEOF); ok {
+				return nil
 			}
+			return err
+		}
+		n, last, err := lastProspectID(page)
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return nil
+		}
+		query.emit(page, PageInfo{Number: number, Limit: limit, After: after})
+		if n < limit {
+			return nil
 		}
-	}()
+		after = last
+	}
+	return nil
+}
 
-	go func() {
-		for {
-			select {
-			case e := <-quit:
-				err = e
-				shut <- struct{}{}
-			case <-shut:
-				return
+// queryProspectsByIDRanges splits the ids up to the greatest one into
+// one range per worker and walks the ranges in parallel.
+// The last range is unbounded so prospects created during the walk are
+// not lost.
+func (p *Pargo) queryProspectsByIDRanges(query QueryAllProspects) error {
+	var page json.RawMessage
+	err := p.QueryProspects(QueryProspects{
+		Limit:     1,
+		Fields:    []string{"id"},
+		SortBy:    "id",
+		SortOrder: "descending",
+		Marshaler: func(r json.RawMessage) { page = r },
+	})
+	if err != nil {
+		if _, ok := err.(QueryProspectsEOF); ok {
+			return nil
+		}
+		return err
+	}
+	_, maxID, err := lastProspectID(page)
+	if err != nil {
+		return err
+	}
+
+	g := newGroup()
+	workers := query.workers()
+	for i := 0; i < workers; i++ {
+		after := maxID * i / workers
+		before := 0
+		if i < workers-1 {
+			before = maxID*(i+1)/workers + 1
+		}
+		g.Go(func() error {
+			return p.queryProspectsByID(query, g, after, before)
+		})
+	}
+	return g.Wait()
+}
+
+// withFields returns fields including the required ones, which paging
+// depends on.
+func withFields(fields []string, required ...string) []string {
+	out := append([]string{}, fields...)
+next:
+	for _, r := range required {
+		for _, f := range fields {
+			if f == r {
+				continue next
 			}
 		}
-	}()
+		out = append(out, r)
+	}
+	return out
+}
 
-	wg.Add(workers)
-	go func() {
-		for range done {
-			wg.Done()
+// splitPage returns each record of a page.
+// Pardot returns a single object instead of an array when the page has
+// only one record.
+func splitPage(page json.RawMessage) ([]json.RawMessage, error) {
+	var records []json.RawMessage
+	err := json.Unmarshal(page, &records)
+	if err != nil {
+		var single json.RawMessage
+		if json.Unmarshal(page, &single) != nil {
+			return nil, errors.Wrap(err, "reading page")
 		}
-	}()
-	wg.Wait()
-	shut <- struct{}{}
+		records = []json.RawMessage{single}
+	}
+	return records, nil
+}
 
-	return err
+// lastProspectID returns the number of prospects in a page and the
+// greatest id amongst them.
+func lastProspectID(page json.RawMessage) (n, last int, err error) {
+	records, err := splitPage(page)
+	if err != nil {
+		return 0, 0, err
+	}
+	for _, r := range records {
+		var p struct {
+			ID int `json:"id"`
+		}
+		if err := json.Unmarshal(r, &p); err != nil {
+			return 0, 0, errors.Wrap(err, "reading prospect id")
+		}
+		if p.ID > last {
+			last = p.ID
+		}
+	}
+	return len(records), last, nil
 }
@@ -5,9 +5,11 @@ import (
 	"encoding/json"
 	"io/ioutil"
 	"net/http"
+	"strconv"
 	"strings"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/brunoflores/pargo"
 )
@@ -116,3 +118,316 @@ func TestQueryAllProspects(t *testing.T) {
 		t.Fatalf("len(prospects) = %d; want %d", got, 1)
 	}
 }
+
+func TestQueryAllProspectsKeyset(t *testing.T) {
+	var ids []int
+	for i := 1; i <= 1001; i++ {
+		ids = append(ids, i*3)
+	}
+	for _, paging := range []pargo.Paging{
+		pargo.PagingKeyset,
+		pargo.PagingKeysetRanges,
+	} {
+		client := newTestClient(newProspectsServer(t, testProspects(ids), nil))
+		seen := make(map[int]int)
+		var mu sync.Mutex
+		err := client.QueryAllProspects(pargo.QueryAllProspects{
+			Fields: []string{"email"},
+			Paging: paging,
+			Page: func(data json.RawMessage) {
+				var page []struct {
+					ID int `json:"id"`
+				}
+				if err := json.Unmarshal(data, &page); err != nil {
+					var single struct {
+						ID int `json:"id"`
+					}
+					if err := json.Unmarshal(data, &single); err != nil {
+						t.Fatal(err)
+					}
+					page = append(page, single)
+				}
+				mu.Lock()
+				defer mu.Unlock()
+				for _, p := range page {
+					seen[p.ID]++
+				}
+			},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := len(seen); got != len(ids) {
+			t.Fatalf("paging %d: saw %d prospects; want %d", paging, got, len(ids))
+		}
+		for id, n := range seen {
+			if n != 1 {
+				t.Fatalf("paging %d: saw prospect %d %d times", paging, id, n)
+			}
+		}
+	}
+}
+
+func TestQueryAllProspectsRequiresPage(t *testing.T) {
+	client := newTestClient(newProspectsServer(t, testProspects([]int{1}), nil))
+	for _, paging := range []pargo.Paging{
+		pargo.PagingOffset,
+		pargo.PagingKeyset,
+		pargo.PagingKeysetRanges,
+	} {
+		err := client.QueryAllProspects(pargo.QueryAllProspects{
+			Fields: []string{"id"},
+			Paging: paging,
+		})
+		if err == nil {
+			t.Fatalf("paging %d: want error; got nil", paging)
+		}
+	}
+}
+
+func TestQueryAllProspectsNoLeaks(t *testing.T) {
+	defer checkNoLeaks(t)()
+
+	var ids []int
+	for i := 1; i <= 45; i++ {
+		ids = append(ids, i)
+	}
+	client := newTestClient(newProspectsServer(t, testProspects(ids), nil))
+	var mu sync.Mutex
+	var n int
+	err := client.QueryAllProspects(pargo.QueryAllProspects{
+		Fields:   []string{"id"},
+		PageSize: 10,
+		Page: func(data json.RawMessage) {
+			var page []struct{}
+			if err := json.Unmarshal(data, &page); err != nil {
+				t.Fatal(err)
+			}
+			mu.Lock()
+			n += len(page)
+			mu.Unlock()
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != len(ids) {
+		t.Fatalf("got %d prospects; want %d", n, len(ids))
+	}
+}
+
+func TestQueryAllProspectsStopsOnError(t *testing.T) {
+	defer checkNoLeaks(t)()
+
+	const workers = 3
+	var (
+		mu              sync.Mutex
+		inFlight, maxIn int
+		calls           int
+		limits          = make(map[string]bool)
+		busy            = make(chan struct{})
+		failed          = make(chan struct{})
+	)
+	testClient := newTestHTTPClient(func(req *http.Request) *http.Response {
+		u := req.URL.Path
+		if strings.Contains(u, `oauth2/`) {
+			return &http.Response{
+				StatusCode: 200,
+				Body:       ioutil.NopCloser(bytes.NewBufferString(`{}`)),
+				Header:     make(http.Header)}
+		}
+		mu.Lock()
+		calls++
+		inFlight++
+		if inFlight > maxIn {
+			maxIn = inFlight
+		}
+		limits[req.FormValue("limit")] = true
+		if calls == workers {
+			close(busy)
+		}
+		mu.Unlock()
+		defer func() {
+			mu.Lock()
+			inFlight--
+			mu.Unlock()
+		}()
+
+		// Every worker has a page in flight when the first one fails,
+		// and the others are answered once it has had time to stop
+		// the group.
+		<-busy
+		if req.FormValue("offset") == "0" {
+			defer close(failed)
+			return &http.Response{
+				StatusCode: 503,
+				Body:       ioutil.NopCloser(bytes.NewBufferString(`{}`)),
+				Header:     make(http.Header)}
+		}
+		<-failed
+		time.Sleep(50 * time.Millisecond)
+		return &http.Response{
+			StatusCode: 200,
+			Body: ioutil.NopCloser(bytes.NewBufferString(
+				`{"result":{"prospect":[{"id":1}]}}`)),
+			Header: make(http.Header)}
+	})
+	client := newTestClient(testClient)
+	err := client.QueryAllProspects(pargo.QueryAllProspects{
+		Fields:   []string{"id"},
+		Workers:  workers,
+		PageSize: 50,
+		Page:     func(json.RawMessage) {},
+	})
+	if err == nil {
+		t.Fatal("want error; got nil")
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if maxIn > workers {
+		t.Fatalf("%d pages in flight; want at most %d", maxIn, workers)
+	}
+	if calls != workers {
+		t.Fatalf("%d pages requested after the error", calls-workers)
+	}
+	if len(limits) != 1 || !limits["50"] {
+		t.Fatalf("got limits %v; want only 50", limits)
+	}
+}
+
+// An error from a page requested before the empty page must not be lost.
+func TestQueryAllProspectsErrorAfterEOF(t *testing.T) {
+	defer checkNoLeaks(t)()
+
+	empty := make(chan struct{})
+	testClient := newTestHTTPClient(func(req *http.Request) *http.Response {
+		u := req.URL.Path
+		if strings.Contains(u, `oauth2/`) {
+			return &http.Response{
+				StatusCode: 200,
+				Body:       ioutil.NopCloser(bytes.NewBufferString(`{}`)),
+				Header:     make(http.Header)}
+		}
+		if req.FormValue("offset") == "0" {
+			<-empty
+			time.Sleep(50 * time.Millisecond)
+			return &http.Response{
+				StatusCode: 503,
+				Body:       ioutil.NopCloser(bytes.NewBufferString(`{}`)),
+				Header:     make(http.Header)}
+		}
+		if req.FormValue("offset") == "200" {
+			defer close(empty)
+		}
+		return &http.Response{
+			StatusCode: 200,
+			Body: ioutil.NopCloser(bytes.NewBufferString(
+				`{"result":{"total_results":0}}`)),
+			Header: make(http.Header)}
+	})
+	client := newTestClient(testClient)
+	err := client.QueryAllProspects(pargo.QueryAllProspects{
+		Fields:  []string{"id"},
+		Workers: 2,
+		Page:    func(json.RawMessage) {},
+	})
+	if err == nil {
+		t.Fatal("want error; got nil")
+	}
+}
+
+func TestQueryAllProspectsLimits(t *testing.T) {
+	client := newTestClient(newProspectsServer(t, testProspects([]int{1}), nil))
+	for _, query := range []pargo.QueryAllProspects{
+		{Workers: 6},
+		{PageSize: 201},
+		{PageSize: 201, Paging: pargo.PagingKeyset},
+	} {
+		query.Page = func(json.RawMessage) {}
+		if err := client.QueryAllProspects(query); err == nil {
+			t.Fatalf("%+v: want error; got nil", query)
+		}
+	}
+}
+
+func TestQueryAllProspectsOrdered(t *testing.T) {
+	defer checkNoLeaks(t)()
+
+	const buffer = 3
+	var ids []int
+	for i := 1; i <= 95; i++ {
+		ids = append(ids, i)
+	}
+	server := newProspectsServer(t, testProspects(ids), nil)
+	var (
+		mu                 sync.Mutex
+		started, delivered int
+	)
+	testClient := newTestHTTPClient(func(req *http.Request) *http.Response {
+		if strings.Contains(req.URL.Path, `/query`) {
+			mu.Lock()
+			started++
+			if ahead := started - delivered; ahead > buffer {
+				t.Errorf("%d pages requested ahead of delivery; want at most %d",
+					ahead, buffer)
+			}
+			mu.Unlock()
+			// Earlier pages are slower, so later ones arrive first.
+			offset, _ := strconv.Atoi(req.FormValue("offset"))
+			time.Sleep(time.Duration(100-offset) * 100 * time.Microsecond)
+		}
+		res, err := server.Transport.RoundTrip(req)
+		if err != nil {
+			t.Error(err)
+		}
+		return res
+	})
+	client := newTestClient(testClient)
+
+	var (
+		calling bool
+		next    int
+		lastID  int
+	)
+	err := client.QueryAllProspects(pargo.QueryAllProspects{
+		Fields:        []string{"id"},
+		PageSize:      10,
+		Ordered:       true,
+		ReorderBuffer: buffer,
+		PageAt: func(data json.RawMessage, info pargo.PageInfo) {
+			mu.Lock()
+			if calling {
+				t.Error("PageAt called concurrently")
+			}
+			calling = true
+			delivered++
+			mu.Unlock()
+			defer func() {
+				mu.Lock()
+				calling = false
+				mu.Unlock()
+			}()
+
+			if info.Number != next || info.Offset != next*10 || info.Limit != 10 {
+				t.Errorf("got %+v; want page %d", info, next)
+			}
+			next++
+			var page []testProspect
+			if err := json.Unmarshal(data, &page); err != nil {
+				t.Fatal(err)
+			}
+			for _, p := range page {
+				if p.ID != lastID+1 {
+					t.Fatalf("got prospect %d after %d", p.ID, lastID)
+				}
+				lastID = p.ID
+			}
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lastID != len(ids) {
+		t.Fatalf("last prospect %d; want %d", lastID, len(ids))
+	}
+}
@@ -5,10 +5,14 @@ import (
 	"net/http"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 )
 
+// timeLayout is how Pardot formats dates, in the account timezone.
+const timeLayout = "2006-01-02 15:04:05"
+
 type QueryProspectsEOF struct{}
 
 func (QueryProspectsEOF) Error() string {
@@ -24,6 +28,15 @@ type QueryProspects struct {
 	// Optional fields.
 	PlaceHolder interface{}
 	Marshaler   func(json.RawMessage)
+
+	// Optional filters.
+	// Zero values are not sent to Pardot.
+	IDGreaterThan, IDLessThan   int
+	UpdatedAfter, UpdatedBefore time.Time
+	SortBy, SortOrder           string
+
+	// Deleted is one of "true", "false" or "all".
+	Deleted string
 }
 
 // QueryProspects executes the endpoint with arguments.
@@ -53,11 +66,23 @@ func (QueryProspects) Path() string {
 }
 
 func (q QueryProspects) Query() (map[string]string, error) {
-	return map[string]string{
+	query := map[string]string{
 		"offset": strconv.Itoa(q.Offset),
 		"limit":  strconv.Itoa(q.Limit),
 		"fields": strings.Join(q.Fields, ","),
-	}, nil
+	}
+	addIDRange(query, q.IDGreaterThan, q.IDLessThan)
+	addTimeRange(query, "updated", q.UpdatedAfter, q.UpdatedBefore)
+	if q.Deleted != "" {
+		query["deleted"] = q.Deleted
+	}
+	if q.SortBy != "" {
+		query["sort_by"] = q.SortBy
+	}
+	if q.SortOrder != "" {
+		query["sort_order"] = q.SortOrder
+	}
+	return query, nil
 }
 
 func (q QueryProspects) readQueryProspects(res []byte) error {
@@ -0,0 +1,206 @@
+package pargo
+
+import (
+	"encoding/json"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Checkpoint is the last prospect seen by SyncProspects, ordered by the
+// time it was last updated and then by id.
+// It is meant to be persisted between runs, typically as JSON.
+//
+// Pardot dates are wall times in the timezone of the account, without
+// an offset. UpdatedAt holds that wall time with UTC as a placeholder
+// location, so a Since built from another time.Time must first be
+// converted to the account timezone and then given the UTC location.
+type Checkpoint struct {
+	UpdatedAt time.Time `json:"updated_at"`
+	ID        int       `json:"id"`
+}
+
+// before tells whether c sorts before the checkpoint o.
+func (c Checkpoint) before(o Checkpoint) bool {
+	if !c.UpdatedAt.Equal(o.UpdatedAt) {
+		return c.UpdatedAt.Before(o.UpdatedAt)
+	}
+	return c.ID < o.ID
+}
+
+// SyncProspects is the set of arguments to page through the prospects
+// updated after a checkpoint.
+type SyncProspects struct {
+	// Since is the checkpoint returned by the previous run.
+	// The zero value syncs every prospect.
+	Since Checkpoint
+
+	// Fields to return. The fields "id" and "updated_at" are always
+	// requested.
+	Fields []string
+
+	// IncludeDeleted also returns prospects that have been deleted.
+	IncludeDeleted bool
+
+	// Page is required. It is called serially, in checkpoint order, with a JSON array
+	// of prospects and the checkpoint after the last one of them.
+	Page func(json.RawMessage, Checkpoint)
+}
+
+// SyncProspects calls args.Page with every prospect updated after
+// args.Since and returns the checkpoint to start from next time.
+//
+// Pardot can only sort by one column and its dates have a precision of
+// one second, so prospects updated in the same second as the checkpoint
+// are drained by id before moving on to later seconds.
+func (p *Pargo) SyncProspects(args SyncProspects) (Checkpoint, error) {
+	const limit = 200
+	if args.Page == nil {
+		return args.Since, errors.New("missing Page callback")
+	}
+	fields := withFields(args.Fields, "id", "updated_at")
+	deleted := ""
+	if args.IncludeDeleted {
+		deleted = "all"
+	}
+	cp := args.Since
+	for {
+		if !cp.UpdatedAt.IsZero() {
+			var err error
+			cp, err = p.syncProspectsAt(args, cp, fields, deleted)
+			if err != nil {
+				return cp, err
+			}
+		}
+
+		records, err := p.syncProspectsPage(QueryProspects{
+			Limit:        limit,
+			Fields:       fields,
+			UpdatedAfter: cp.UpdatedAt,
+			SortBy:       "updated_at",
+			SortOrder:    "ascending",
+			Deleted:      deleted,
+		})
+		if err != nil {
+			return cp, err
+		}
+		if len(records) == 0 {
+			return cp, nil
+		}
+
+		// The last second of a full page may have more prospects in the
+		// next page, so they are left to be drained by id.
+		complete := len(records) < limit
+		last := records[len(records)-1].UpdatedAt
+		var page []syncRecord
+		for _, r := range records {
+			if complete || r.UpdatedAt.Before(last) {
+				page = append(page, r)
+			}
+		}
+		cp = emitSyncPage(args, page, cp)
+		if complete {
+			return cp, nil
+		}
+		cp = Checkpoint{UpdatedAt: last}
+	}
+}
+
+// syncProspectsAt pages by id through the prospects updated in the same
+// second as cp and after it.
+func (p *Pargo) syncProspectsAt(
+	args SyncProspects,
+	cp Checkpoint,
+	fields []string,
+	deleted string,
+) (Checkpoint, error) {
+	const limit = 200
+	for {
+		records, err := p.syncProspectsPage(QueryProspects{
+			Limit:         limit,
+			Fields:        fields,
+			IDGreaterThan: cp.ID,
+			UpdatedAfter:  cp.UpdatedAt.Add(-time.Second),
+			UpdatedBefore: cp.UpdatedAt.Add(time.Second),
+			SortBy:        "id",
+			SortOrder:     "ascending",
+			Deleted:       deleted,
+		})
+		if err != nil {
+			return cp, err
+		}
+		cp = emitSyncPage(args, records, cp)
+		if len(records) < limit {
+			return cp, nil
+		}
+	}
+}
+
+// syncRecord is a prospect with the fields needed to order it.
+type syncRecord struct {
+	Checkpoint
+	raw json.RawMessage
+}
+
+func (p *Pargo) syncProspectsPage(q QueryProspects) ([]syncRecord, error) {
+	var page json.RawMessage
+	q.Marshaler = func(r json.RawMessage) { page = r }
+	err := p.QueryProspects(q)
+	if err != nil {
+		if _, ok := err.(QueryProspectsEOF); ok {
+			return nil, nil
+		}
+		return nil, err
+	}
+	raws, err := splitPage(page)
+	if err != nil {
+		return nil, err
+	}
+	records := make([]syncRecord, 0, len(raws))
+	for _, raw := range raws {
+		var r struct {
+			ID        int    `json:"id"`
+			UpdatedAt string `json:"updated_at"`
+		}
+		if err := json.Unmarshal(raw, &r); err != nil {
+			return nil, errors.Wrap(err, "reading prospect")
+		}
+		updatedAt, err := time.Parse(timeLayout, r.UpdatedAt)
+		if err != nil {
+			return nil, errors.Wrap(err, "parsing updated_at")
+		}
+		records = append(records, syncRecord{
+			Checkpoint: Checkpoint{UpdatedAt: updatedAt, ID: r.ID},
+			raw:        raw,
+		})
+	}
+	return records, nil
+}
+
+// emitSyncPage hands the records after cp to args.Page, in checkpoint
+// order, and returns the checkpoint after the last one of them.
+func emitSyncPage(
+	args SyncProspects,
+	records []syncRecord,
+	cp Checkpoint,
+) Checkpoint {
+	sort.Slice(records, func(i, j int) bool {
+		return records[i].before(records[j].Checkpoint)
+	})
+	var page []json.RawMessage
+	for _, r := range records {
+		if !cp.before(r.Checkpoint) {
+			continue
+		}
+		page = append(page, r.raw)
+		cp = r.Checkpoint
+	}
+	if len(page) == 0 {
+		return cp
+	}
+	// Marshaling raw messages that came from Pardot cannot fail.
+	b, _ := json.Marshal(page)
+	args.Page(b, cp)
+	return cp
+}
@@ -0,0 +1,98 @@
+package pargo_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/brunoflores/pargo"
+)
+
+func TestSyncProspects(t *testing.T) {
+	for _, includeDeleted := range []bool{false, true} {
+		testSyncProspects(t, includeDeleted)
+	}
+}
+
+func testSyncProspects(t *testing.T, includeDeleted bool) {
+	var prospects []testProspect
+	// More prospects updated in the same second than fit in a page,
+	// listed with descending ids.
+	for id := 500; id > 250; id-- {
+		prospects = append(prospects, testProspect{id, "2020-01-01 10:00:00"})
+	}
+	for id := 1; id <= 250; id++ {
+		prospects = append(prospects, testProspect{
+			id, fmt.Sprintf("2020-01-01 %02d:00:00", id%10)})
+	}
+	wantDeleted := ""
+	if includeDeleted {
+		wantDeleted = "all"
+	}
+	client := newTestClient(newProspectsServer(t, &prospects,
+		func(req *http.Request) {
+			if got := req.FormValue("deleted"); got != wantDeleted {
+				t.Errorf("deleted = %q; want %q", got, wantDeleted)
+			}
+		}))
+
+	sync := func(since pargo.Checkpoint) ([]int, pargo.Checkpoint) {
+		var ids []int
+		var last pargo.Checkpoint
+		cp, err := client.SyncProspects(pargo.SyncProspects{
+			Since:          since,
+			IncludeDeleted: includeDeleted,
+			Page: func(data json.RawMessage, cp pargo.Checkpoint) {
+				var page []testProspect
+				if err := json.Unmarshal(data, &page); err != nil {
+					t.Fatal(err)
+				}
+				for _, p := range page {
+					ids = append(ids, p.ID)
+				}
+				if cp.UpdatedAt.Before(last.UpdatedAt) {
+					t.Fatalf("checkpoint %v went back from %v", cp, last)
+				}
+				last = cp
+			},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if cp != last {
+			t.Fatalf("returned checkpoint %v; last page had %v", cp, last)
+		}
+		return ids, cp
+	}
+
+	ids, cp := sync(pargo.Checkpoint{})
+	seen := make(map[int]bool)
+	for _, id := range ids {
+		if seen[id] {
+			t.Fatalf("prospect %d seen twice", id)
+		}
+		seen[id] = true
+	}
+	if len(seen) != len(prospects) {
+		t.Fatalf("saw %d prospects; want %d", len(seen), len(prospects))
+	}
+
+	b, err := json.Marshal(cp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var restored pargo.Checkpoint
+	if err := json.Unmarshal(b, &restored); err != nil {
+		t.Fatal(err)
+	}
+
+	prospects = append(prospects,
+		testProspect{501, "2020-01-01 10:00:00"},
+		testProspect{3, "2020-01-02 00:00:00"},
+	)
+	ids, _ = sync(restored)
+	if len(ids) != 2 || ids[0] != 501 || ids[1] != 3 {
+		t.Fatalf("got %v; want [501 3]", ids)
+	}
+}
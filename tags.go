@@ -0,0 +1,204 @@
+package pargo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+// Tag is a label applied to Pardot objects.
+type Tag struct {
+	ID        int    `json:"id"`
+	Name      string `json:"name"`
+	CreatedAt Time   `json:"created_at"`
+	UpdatedAt Time   `json:"updated_at"`
+}
+
+// TagObject is the application of a tag to an object.
+type TagObject struct {
+	ID        int    `json:"id"`
+	TagID     int    `json:"tag_id"`
+	Type      string `json:"type"`
+	ObjectID  int    `json:"object_id"`
+	CreatedAt Time   `json:"created_at"`
+}
+
+// Object types a tag can be applied to, as used by TagObject.Type.
+const (
+	TagObjectProspect      = "Prospect"
+	TagObjectList          = "List"
+	TagObjectCampaign      = "Campaign"
+	TagObjectEmailTemplate = "Email Template"
+	TagObjectForm          = "Form"
+	TagObjectLandingPage   = "Landing Page"
+	TagObjectOpportunity   = "Opportunity"
+)
+
+// QueryTags is an endpoint to query a page of tags.
+type QueryTags struct {
+	Offset, Limit int
+	QueryFilters
+
+	// Name matches tags whose name contains it.
+	Name string
+
+	Placeholder *[]Tag
+}
+
+// QueryTags executes the endpoint with arguments.
+func (p *Pargo) QueryTags(args QueryTags) error {
+	body, err := p.do(context.Background(), args)
+	if err != nil {
+		return err
+	}
+	tags, err := readRecords[Tag](body, "tag")
+	if err != nil {
+		return err
+	}
+	*args.Placeholder = append(*args.Placeholder, tags...)
+	return nil
+}
+
+func (QueryTags) Method() string {
+	return http.MethodGet
+}
+
+func (QueryTags) Path() string {
+	return "tag/" + version + "/do/query"
+}
+
+func (q QueryTags) Query() (map[string]string, error) {
+	query := q.pageQuery(q.Offset, q.Limit)
+	addString(query, "name", q.Name)
+	return query, nil
+}
+
+// QueryTagsPager returns a Pager over every tag matching q.
+// The offset of q is ignored and its limit is the page size.
+func (p *Pargo) QueryTagsPager(q QueryTags) *Pager[Tag] {
+	return NewPager[Tag](p, "tag", q.Limit,
+		func(offset, limit int) Endpoint {
+			q.Offset, q.Limit = offset, limit
+			return q
+		})
+}
+
+// ReadTag is an endpoint to read a tag by id.
+type ReadTag struct {
+	ID          int
+	Placeholder *Tag
+}
+
+// ReadTag executes the endpoint with arguments.
+func (p *Pargo) ReadTag(args ReadTag) error {
+	body, err := p.do(context.Background(), args)
+	if err != nil {
+		return err
+	}
+	return readRecord(body, "tag", args.Placeholder)
+}
+
+func (ReadTag) Method() string {
+	return http.MethodGet
+}
+
+func (q ReadTag) Path() string {
+	return fmt.Sprintf("tag/%s/do/read/id/%d", version, q.ID)
+}
+
+// QueryTagObjects is an endpoint to query a page of tag applications.
+type QueryTagObjects struct {
+	Offset, Limit int
+	QueryFilters
+
+	// Optional filters.
+	// Zero values are not sent to Pardot.
+	TagID    int
+	Type     string
+	ObjectID int
+
+	Placeholder *[]TagObject
+}
+
+// QueryTagObjects executes the endpoint with arguments.
+func (p *Pargo) QueryTagObjects(args QueryTagObjects) error {
+	body, err := p.do(context.Background(), args)
+	if err != nil {
+		return err
+	}
+	objects, err := readRecords[TagObject](body, "tagObject")
+	if err != nil {
+		return err
+	}
+	*args.Placeholder = append(*args.Placeholder, objects...)
+	return nil
+}
+
+func (QueryTagObjects) Method() string {
+	return http.MethodGet
+}
+
+func (QueryTagObjects) Path() string {
+	return "tagObject/" + version + "/do/query"
+}
+
+func (q QueryTagObjects) Query() (map[string]string, error) {
+	query := q.pageQuery(q.Offset, q.Limit)
+	if q.TagID > 0 {
+		query["tag_id"] = strconv.Itoa(q.TagID)
+	}
+	addString(query, "type", q.Type)
+	if q.ObjectID > 0 {
+		query["object_id"] = strconv.Itoa(q.ObjectID)
+	}
+	return query, nil
+}
+
+// QueryTagObjectsPager returns a Pager over every tag application
+// matching q. The offset of q is ignored and its limit is the page size.
+func (p *Pargo) QueryTagObjectsPager(q QueryTagObjects) *Pager[TagObject] {
+	return NewPager[TagObject](p, "tagObject", q.Limit,
+		func(offset, limit int) Endpoint {
+			q.Offset, q.Limit = offset, limit
+			return q
+		})
+}
+
+// TagsOf returns the tags applied to an object, such as a prospect with
+// objectType TagObjectProspect.
+// Pardot does not allow applying tags through version 4 of its API.
+func (p *Pargo) TagsOf(ctx context.Context, objectType string, objectID int) ([]Tag, error) {
+	pg := p.QueryTagObjectsPager(QueryTagObjects{
+		Limit: maxPageSize, Type: objectType, ObjectID: objectID})
+	var tags []Tag
+	for object, err := range pg.All(ctx) {
+		if err != nil {
+			return nil, err
+		}
+		var tag Tag
+		if err := p.ReadTag(ReadTag{ID: object.TagID, Placeholder: &tag}); err != nil {
+			return nil, err
+		}
+		tags = append(tags, tag)
+	}
+	return tags, nil
+}
+
+// ProspectTags returns the tags applied to a prospect.
+func (p *Pargo) ProspectTags(ctx context.Context, prospectID int) ([]Tag, error) {
+	return p.TagsOf(ctx, TagObjectProspect, prospectID)
+}
+
+// TaggedObjects returns every object carrying a tag.
+func (p *Pargo) TaggedObjects(ctx context.Context, tagID int) ([]TagObject, error) {
+	pg := p.QueryTagObjectsPager(QueryTagObjects{Limit: maxPageSize, TagID: tagID})
+	var objects []TagObject
+	for object, err := range pg.All(ctx) {
+		if err != nil {
+			return nil, err
+		}
+		objects = append(objects, object)
+	}
+	return objects, nil
+}
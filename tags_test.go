@@ -0,0 +1,57 @@
+package pargo_test
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/brunoflores/pargo"
+)
+
+func TestProspectTags(t *testing.T) {
+	client := newCannedClient(func(req *http.Request) string {
+		switch {
+		case strings.HasSuffix(req.URL.Path, "tagObject/version/4/do/query"):
+			if req.FormValue("type") != "Prospect" || req.FormValue("object_id") != "9" {
+				t.Errorf("query = %v", req.Form)
+			}
+			return `{"result":{"total_results":2,"tagObject":[
+				{"id":1,"tag_id":5,"type":"Prospect","object_id":9},
+				{"id":2,"tag_id":6,"type":"Prospect","object_id":9}]}}`
+		case strings.HasSuffix(req.URL.Path, "/read/id/5"):
+			return `{"tag":{"id":5,"name":"vip"}}`
+		case strings.HasSuffix(req.URL.Path, "/read/id/6"):
+			return `{"tag":{"id":6,"name":"partner"}}`
+		}
+		t.Errorf("unexpected request to %s", req.URL.Path)
+		return `{}`
+	})
+
+	tags, err := client.ProspectTags(context.Background(), 9)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tags) != 2 || tags[0].Name != "vip" || tags[1].Name != "partner" {
+		t.Fatalf("got %+v", tags)
+	}
+}
+
+func TestTaggedObjects(t *testing.T) {
+	client := newCannedClient(func(req *http.Request) string {
+		if req.FormValue("tag_id") != "5" {
+			t.Errorf("tag_id = %q", req.FormValue("tag_id"))
+		}
+		// A page with one record is an object rather than an array.
+		return `{"result":{"total_results":1,"tagObject":
+			{"id":1,"tag_id":5,"type":"List","object_id":3}}}`
+	})
+
+	objects, err := client.TaggedObjects(context.Background(), 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(objects) != 1 || objects[0].Type != pargo.TagObjectList || objects[0].ObjectID != 3 {
+		t.Fatalf("got %+v", objects)
+	}
+}
@@ -0,0 +1,98 @@
+package pargo
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Time is a date as formatted by Pardot.
+//
+// Pardot dates are wall times in the timezone of the account, without
+// an offset. Time holds that wall time with UTC as a placeholder
+// location. Empty dates are the zero Time.
+type Time struct {
+	time.Time
+}
+
+func (t *Time) UnmarshalJSON(b []byte) error {
+	var s *string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return errors.Wrap(err, "reading date")
+	}
+	if s == nil || *s == "" {
+		t.Time = time.Time{}
+		return nil
+	}
+	parsed, err := time.Parse(timeLayout, *s)
+	if err != nil {
+		return errors.Wrap(err, "parsing date")
+	}
+	t.Time = parsed
+	return nil
+}
+
+func (t Time) MarshalJSON() ([]byte, error) {
+	if t.IsZero() {
+		return []byte(`null`), nil
+	}
+	return json.Marshal(t.Format(timeLayout))
+}
+
+// Bool is a boolean as returned by Pardot, which may come as true or
+// false, as 1 or 0, as any of those quoted, or as null for false.
+type Bool bool
+
+func (v *Bool) UnmarshalJSON(b []byte) error {
+	s := strings.ToLower(string(bytes.Trim(b, `"`)))
+	switch s {
+	case "true", "1":
+		*v = true
+	case "false", "0", "", "null":
+		*v = false
+	default:
+		return errors.Errorf("reading boolean %s", b)
+	}
+	return nil
+}
+
+// looseNumber returns the number in b, which Pardot may send as a
+// number, a string, with thousands separators or a percent sign.
+// Empty strings and null are zero.
+func looseNumber(b []byte) string {
+	s := strings.TrimSpace(string(bytes.Trim(b, `"`)))
+	s = strings.TrimSuffix(strings.ReplaceAll(s, ",", ""), "%")
+	if s == "" || s == "null" {
+		return "0"
+	}
+	return s
+}
+
+// Int is an integer as returned by Pardot, such as email stats.
+type Int int
+
+func (v *Int) UnmarshalJSON(b []byte) error {
+	n, err := strconv.Atoi(looseNumber(b))
+	if err != nil {
+		return errors.Errorf("reading integer %s", b)
+	}
+	*v = Int(n)
+	return nil
+}
+
+// Float is a number as returned by Pardot. Percentages such as "12.5%"
+// are read as 12.5.
+type Float float64
+
+func (v *Float) UnmarshalJSON(b []byte) error {
+	f, err := strconv.ParseFloat(looseNumber(b), 64)
+	if err != nil {
+		return errors.Errorf("reading number %s", b)
+	}
+	*v = Float(f)
+	return nil
+}
@@ -0,0 +1,74 @@
+package pargo_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/brunoflores/pargo"
+)
+
+func TestBool(t *testing.T) {
+	tests := map[string]bool{
+		`true`: true, `"true"`: true, `1`: true, `"1"`: true,
+		`false`: false, `"false"`: false, `0`: false, `"0"`: false,
+		`null`: false, `""`: false,
+	}
+	for in, want := range tests {
+		var got pargo.Bool
+		if err := json.Unmarshal([]byte(in), &got); err != nil {
+			t.Fatalf("%s: %v", in, err)
+		}
+		if bool(got) != want {
+			t.Errorf("%s: got %v; want %v", in, got, want)
+		}
+	}
+	var b pargo.Bool
+	if err := json.Unmarshal([]byte(`"maybe"`), &b); err == nil {
+		t.Fatal("want error; got nil")
+	}
+}
+
+func TestTime(t *testing.T) {
+	var got struct {
+		A, B, C pargo.Time
+	}
+	err := json.Unmarshal(
+		[]byte(`{"A":"2019-03-11 06:56:38","B":"","C":null}`), &got)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := time.Date(2019, 3, 11, 6, 56, 38, 0, time.UTC)
+	if !got.A.Equal(want) || !got.B.IsZero() || !got.C.IsZero() {
+		t.Fatalf("got %+v", got)
+	}
+	b, err := json.Marshal(got)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := `{"A":"2019-03-11 06:56:38","B":null,"C":null}`; string(b) != s {
+		t.Fatalf("got %s; want %s", b, s)
+	}
+}
+
+func TestNumbers(t *testing.T) {
+	var got struct {
+		I    pargo.Int
+		F    pargo.Float
+		Q, P pargo.Float
+		Z, N pargo.Int
+	}
+	err := json.Unmarshal([]byte(
+		`{"I":"1,024","F":2.5,"Q":"7","P":"12.5%","Z":"","N":null}`), &got)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.I != 1024 || got.F != 2.5 || got.Q != 7 || got.P != 12.5 ||
+		got.Z != 0 || got.N != 0 {
+		t.Fatalf("got %+v", got)
+	}
+	var i pargo.Int
+	if err := json.Unmarshal([]byte(`"1.5"`), &i); err == nil {
+		t.Fatal("want error; got nil")
+	}
+}
@@ -0,0 +1,225 @@
+package pargo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// User is a Pardot user, such as the assigned user of a prospect.
+type User struct {
+	ID        int    `json:"id"`
+	Email     string `json:"email"`
+	FirstName string `json:"first_name"`
+	LastName  string `json:"last_name"`
+	JobTitle  string `json:"job_title"`
+	Role      string `json:"role"`
+	Account   int    `json:"account"`
+	Timezone  string `json:"timezone"`
+	CreatedAt Time   `json:"created_at"`
+	UpdatedAt Time   `json:"updated_at"`
+}
+
+// QueryUsers is an endpoint to query a page of users.
+type QueryUsers struct {
+	Offset, Limit int
+	QueryFilters
+
+	Placeholder *[]User
+}
+
+// QueryUsers executes the endpoint with arguments.
+func (p *Pargo) QueryUsers(args QueryUsers) error {
+	body, err := p.do(context.Background(), args)
+	if err != nil {
+		return err
+	}
+	users, err := readRecords[User](body, "user")
+	if err != nil {
+		return err
+	}
+	*args.Placeholder = append(*args.Placeholder, users...)
+	return nil
+}
+
+func (QueryUsers) Method() string {
+	return http.MethodGet
+}
+
+func (QueryUsers) Path() string {
+	return "user/" + version + "/do/query"
+}
+
+func (q QueryUsers) Query() (map[string]string, error) {
+	return q.pageQuery(q.Offset, q.Limit), nil
+}
+
+// QueryUsersPager returns a Pager over every user matching q.
+// The offset of q is ignored and its limit is the page size.
+func (p *Pargo) QueryUsersPager(q QueryUsers) *Pager[User] {
+	return NewPager[User](p, "user", q.Limit,
+		func(offset, limit int) Endpoint {
+			q.Offset, q.Limit = offset, limit
+			return q
+		})
+}
+
+// ReadUser is an endpoint to read a user by id or, when ID is zero,
+// by email.
+type ReadUser struct {
+	ID          int
+	Email       string
+	Placeholder *User
+}
+
+// ReadUser executes the endpoint with arguments.
+func (p *Pargo) ReadUser(args ReadUser) error {
+	if args.ID <= 0 && args.Email == "" {
+		return errors.New("missing user id or email")
+	}
+	body, err := p.do(context.Background(), args)
+	if err != nil {
+		return err
+	}
+	return readRecord(body, "user", args.Placeholder)
+}
+
+func (ReadUser) Method() string {
+	return http.MethodGet
+}
+
+func (q ReadUser) Path() string {
+	if q.ID > 0 {
+		return fmt.Sprintf("user/%s/do/read/id/%d", version, q.ID)
+	}
+	return fmt.Sprintf("user/%s/do/read/email/%s", version, q.Email)
+}
+
+// ReadAbilities is an endpoint to read the abilities of the user the
+// client is authenticated as, such as "prospects > view".
+type ReadAbilities struct {
+	Placeholder *[]string
+}
+
+// ReadAbilities executes the endpoint with arguments.
+func (p *Pargo) ReadAbilities(args ReadAbilities) error {
+	body, err := p.do(context.Background(), args)
+	if err != nil {
+		return err
+	}
+	var result struct {
+		Abilities json.RawMessage `json:"abilities"`
+	}
+	if err := readRecord(body, "result", &result); err != nil {
+		return err
+	}
+	// Depending on the account, abilities are either a list or an
+	// object wrapping it.
+	var wrapped struct {
+		Ability json.RawMessage `json:"ability"`
+	}
+	raw := result.Abilities
+	if json.Unmarshal(raw, &wrapped) == nil && wrapped.Ability != nil {
+		raw = wrapped.Ability
+	}
+	abilities, err := decodeRecords[string](raw)
+	if err != nil {
+		return err
+	}
+	*args.Placeholder = append(*args.Placeholder, abilities...)
+	return nil
+}
+
+func (ReadAbilities) Method() string {
+	return http.MethodGet
+}
+
+func (ReadAbilities) Path() string {
+	return "user/" + version + "/do/abilities"
+}
+
+// UserDirectory resolves user ids to emails and back, loading every
+// user from Pardot the first time it is asked for one.
+// It is safe for concurrent use.
+type UserDirectory struct {
+	client  *Pargo
+	mu      sync.Mutex
+	loaded  bool
+	byID    map[int]User
+	byEmail map[string]User
+}
+
+// NewUserDirectory returns a directory that is loaded on first use.
+func NewUserDirectory(p *Pargo) *UserDirectory {
+	return &UserDirectory{client: p}
+}
+
+// Load reads every user from Pardot, replacing those already loaded.
+func (d *UserDirectory) Load(ctx context.Context) error {
+	byID := make(map[int]User)
+	byEmail := make(map[string]User)
+	pg := d.client.QueryUsersPager(QueryUsers{Limit: maxPageSize})
+	for user, err := range pg.All(ctx) {
+		if err != nil {
+			return err
+		}
+		byID[user.ID] = user
+		byEmail[strings.ToLower(user.Email)] = user
+	}
+	d.mu.Lock()
+	d.byID, d.byEmail, d.loaded = byID, byEmail, true
+	d.mu.Unlock()
+	return nil
+}
+
+// lookup loads the directory if needed and calls fn with it locked.
+func (d *UserDirectory) lookup(fn func() bool) (bool, error) {
+	d.mu.Lock()
+	loaded := d.loaded
+	d.mu.Unlock()
+	if !loaded {
+		if err := d.Load(context.Background()); err != nil {
+			return false, err
+		}
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return fn(), nil
+}
+
+// Email returns the email of the user with the id.
+func (d *UserDirectory) Email(id int) (string, error) {
+	var user User
+	found, err := d.lookup(func() (ok bool) {
+		user, ok = d.byID[id]
+		return ok
+	})
+	if err != nil {
+		return "", err
+	}
+	if !found {
+		return "", errors.Errorf("unknown user %d", id)
+	}
+	return user.Email, nil
+}
+
+// ID returns the id of the user with the email, ignoring case.
+func (d *UserDirectory) ID(email string) (int, error) {
+	var user User
+	found, err := d.lookup(func() (ok bool) {
+		user, ok = d.byEmail[strings.ToLower(email)]
+		return ok
+	})
+	if err != nil {
+		return 0, err
+	}
+	if !found {
+		return 0, errors.Errorf("unknown user %q", email)
+	}
+	return user.ID, nil
+}
@@ -0,0 +1,89 @@
+package pargo_test
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/brunoflores/pargo"
+)
+
+func TestReadUser(t *testing.T) {
+	var got *http.Request
+	client := newCannedClient(func(req *http.Request) string {
+		got = req
+		return `{"user":{"id":3,"email":"ann@example.com","role":"Sales"}}`
+	})
+
+	var user pargo.User
+	if err := client.ReadUser(pargo.ReadUser{ID: 3, Placeholder: &user}); err != nil {
+		t.Fatal(err)
+	}
+	if got.URL.Path != "/api/user/version/4/do/read/id/3" || user.Email != "ann@example.com" {
+		t.Fatalf("%s: got %+v", got.URL.Path, user)
+	}
+	err := client.ReadUser(pargo.ReadUser{Email: "ann@example.com", Placeholder: &user})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.URL.Path != "/api/user/version/4/do/read/email/ann@example.com" {
+		t.Fatalf("got %s", got.URL.Path)
+	}
+	if err := client.ReadUser(pargo.ReadUser{}); err == nil {
+		t.Fatal("expected an error without an id or email")
+	}
+}
+
+func TestReadAbilities(t *testing.T) {
+	for _, body := range []string{
+		`{"result":{"abilities":["prospects > view","lists > view"]}}`,
+		`{"result":{"abilities":{"ability":["prospects > view","lists > view"]}}}`,
+	} {
+		client := newCannedClient(func(req *http.Request) string {
+			return body
+		})
+		var abilities []string
+		if err := client.ReadAbilities(pargo.ReadAbilities{Placeholder: &abilities}); err != nil {
+			t.Fatal(err)
+		}
+		if len(abilities) != 2 || abilities[1] != "lists > view" {
+			t.Fatalf("%s: got %v", body, abilities)
+		}
+	}
+}
+
+func TestUserDirectory(t *testing.T) {
+	var mu sync.Mutex
+	queries := 0
+	client := newCannedClient(func(req *http.Request) string {
+		mu.Lock()
+		queries++
+		mu.Unlock()
+		offset, _ := strconv.Atoi(req.FormValue("offset"))
+		var users []string
+		for id := offset + 1; id <= 250 && id <= offset+200; id++ {
+			users = append(users,
+				fmt.Sprintf(`{"id":%d,"email":"User%d@example.com"}`, id, id))
+		}
+		return `{"result":{"user":[` + strings.Join(users, ",") + `]}}`
+	})
+	dir := pargo.NewUserDirectory(client)
+
+	email, err := dir.Email(201)
+	if err != nil || email != "User201@example.com" {
+		t.Fatalf("got %q, %v", email, err)
+	}
+	id, err := dir.ID("user7@EXAMPLE.com")
+	if err != nil || id != 7 {
+		t.Fatalf("got %d, %v", id, err)
+	}
+	if _, err := dir.Email(999); err == nil {
+		t.Fatal("expected an error for an unknown user")
+	}
+	if queries != 2 {
+		t.Fatalf("queried %d pages; want 2", queries)
+	}
+}
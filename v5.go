@@ -0,0 +1,326 @@
+package pargo
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"iter"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Version 5 of the API lives under its own path, takes and returns
+// JSON, selects fields explicitly and pages with a token rather than an
+// offset.
+const versionV5 = "v5/objects"
+
+// ErrV5 is an error returned by version 5 of the API.
+// It implements `error`.
+type ErrV5 struct {
+	StatusCode int    `json:"-"`
+	Code       int    `json:"code"`
+	Message    string `json:"message"`
+}
+
+func (e ErrV5) Error() string {
+	return fmt.Sprintf("got status code %d with error %d: %s",
+		e.StatusCode, e.Code, e.Message)
+}
+
+// ProspectV5 is a prospect as returned by version 5 of the API.
+type ProspectV5 struct {
+	ID           int       `json:"id"`
+	Email        string    `json:"email"`
+	FirstName    string    `json:"firstName"`
+	LastName     string    `json:"lastName"`
+	Company      string    `json:"company"`
+	Score        int       `json:"score"`
+	Grade        string    `json:"grade"`
+	OptedOut     bool      `json:"optedOut"`
+	IsDoNotEmail bool      `json:"isDoNotEmail"`
+	CampaignID   int       `json:"campaignId"`
+	CreatedAt    time.Time `json:"createdAt"`
+	UpdatedAt    time.Time `json:"updatedAt"`
+}
+
+// ListV5 is a list as returned by version 5 of the API.
+type ListV5 struct {
+	ID          int       `json:"id"`
+	Name        string    `json:"name"`
+	Title       string    `json:"title"`
+	Description string    `json:"description"`
+	IsPublic    bool      `json:"isPublic"`
+	IsDynamic   bool      `json:"isDynamic"`
+	CampaignID  int       `json:"campaignId"`
+	CreatedAt   time.Time `json:"createdAt"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+}
+
+// CampaignV5 is a campaign as returned by version 5 of the API.
+type CampaignV5 struct {
+	ID        int       `json:"id"`
+	Name      string    `json:"name"`
+	Cost      int       `json:"cost"`
+	FolderID  int       `json:"folderId"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+func (ProspectV5) objectV5() string { return "prospects" }
+func (ListV5) objectV5() string     { return "lists" }
+func (CampaignV5) objectV5() string { return "campaigns" }
+
+// objectV5 is implemented by the version 5 types, naming their object.
+type objectV5 interface {
+	ProspectV5 | ListV5 | CampaignV5
+	objectV5() string
+}
+
+// fieldsV5 returns the fields of T, which are requested when the caller
+// does not select any.
+func fieldsV5[T objectV5](fields []string) string {
+	if len(fields) == 0 {
+		t := reflect.TypeOf(*new(T))
+		for i := 0; i < t.NumField(); i++ {
+			fields = append(fields, strings.Split(t.Field(i).Tag.Get("json"), ",")[0])
+		}
+	}
+	return strings.Join(fields, ",")
+}
+
+// requestV5 is a request to version 5 of the API.
+type requestV5 struct {
+	method string
+	path   string
+	query  url.Values
+	body   interface{}
+}
+
+func (r requestV5) build(ctx context.Context) (*http.Request, error) {
+	header := make(http.Header)
+	req := http.Request{
+		Method: r.method,
+		URL: &url.URL{
+			Scheme:   "https",
+			Host:     base,
+			Path:     "/api/" + versionV5 + "/" + r.path,
+			RawQuery: r.query.Encode(),
+		},
+		Header: header,
+	}
+	if r.body != nil {
+		b, err := json.Marshal(r.body)
+		if err != nil {
+			return nil, errors.Wrap(err, "marshaling body")
+		}
+		header.Set("Content-Type", "application/json")
+		req.Body = ioutil.NopCloser(bytes.NewReader(b))
+		req.ContentLength = int64(len(b))
+	}
+	return req.WithContext(ctx), nil
+}
+
+// doV5 issues a request to version 5 of the API and returns the body of
+// the response. An expired api key is refreshed once.
+func (p *Pargo) doV5(ctx context.Context, r requestV5) ([]byte, error) {
+	for retried := false; ; retried = true {
+		if err := p.maybeAuth(); err != nil {
+			return nil, err
+		}
+		req, err := r.build(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "building request")
+		}
+		req.Header = p.addAuthHeaders(req.Header)
+		res, body, err := p.send(req)
+		if err != nil {
+			return nil, errors.Wrap(err, "requesting")
+		}
+		if res.StatusCode < 300 {
+			return body, nil
+		}
+		if res.StatusCode == http.StatusUnauthorized && !retried {
+			p.apiKeyMu.Lock()
+			p.apiKey = ""
+			p.apiKeyMu.Unlock()
+			continue
+		}
+		e := ErrV5{StatusCode: res.StatusCode}
+		if json.Unmarshal(body, &e) != nil || e.Message == "" {
+			e.Message = strings.TrimSpace(string(body))
+		}
+		return nil, e
+	}
+}
+
+// QueryV5 is the set of arguments to query objects with version 5 of
+// the API. Zero values are not sent to Pardot.
+type QueryV5 struct {
+	// Fields to return, such as "id" and "email". It defaults to every
+	// field of the type queried.
+	Fields []string
+
+	// Limit is the page size, up to 1000. Pardot defaults to 200.
+	Limit int
+
+	// OrderBy is a field and an optional direction, such as
+	// "updatedAt desc".
+	OrderBy string
+
+	// Filters such as "updatedAtAfter" or "idGreaterThan", as named by
+	// Pardot for each object.
+	Filters map[string]string
+}
+
+// PagerV5 walks through the pages of a version 5 query, using the token
+// returned with each page to fetch the next.
+type PagerV5[T objectV5] struct {
+	client *Pargo
+	q      QueryV5
+}
+
+// QueryV5Pager returns a Pager over every object of type T matching q,
+// such as QueryV5Pager[ProspectV5].
+func QueryV5Pager[T objectV5](p *Pargo, q QueryV5) *PagerV5[T] {
+	return &PagerV5[T]{client: p, q: q}
+}
+
+// Page returns the page with the token, starting with the empty token,
+// and the token of the next page, which is empty after the last one.
+func (pg *PagerV5[T]) Page(ctx context.Context, token string) ([]T, string, error) {
+	query := make(url.Values)
+	if token != "" {
+		// The token carries the rest of the query.
+		query.Set("nextPageToken", token)
+	} else {
+		query.Set("fields", fieldsV5[T](pg.q.Fields))
+		if pg.q.Limit > 0 {
+			query.Set("limit", strconv.Itoa(pg.q.Limit))
+		}
+		if pg.q.OrderBy != "" {
+			query.Set("orderBy", pg.q.OrderBy)
+		}
+		for k, v := range pg.q.Filters {
+			query.Set(k, v)
+		}
+	}
+	body, err := pg.client.doV5(ctx, requestV5{
+		method: http.MethodGet,
+		path:   (*new(T)).objectV5(),
+		query:  query,
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	var page struct {
+		NextPageToken string `json:"nextPageToken"`
+		Values        []T    `json:"values"`
+	}
+	if err := json.Unmarshal(body, &page); err != nil {
+		return nil, "", errors.Wrap(err, "unmarshaling page")
+	}
+	return page.Values, page.NextPageToken, nil
+}
+
+// All returns an iterator over every object.
+// Iteration stops after the first error, which is yielded with the zero
+// T, including the error of ctx once it is done.
+func (pg *PagerV5[T]) All(ctx context.Context) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		for token := ""; ; {
+			if err := ctx.Err(); err != nil {
+				yield(zero, err)
+				return
+			}
+			records, next, err := pg.Page(ctx, token)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+			for _, r := range records {
+				if !yield(r, nil) {
+					return
+				}
+			}
+			if next == "" {
+				return
+			}
+			token = next
+		}
+	}
+}
+
+// objectV5Request returns a request for the object of type T with the
+// id, returning the fields given.
+func objectV5Request[T objectV5](method string, id int, fields []string) requestV5 {
+	path := (*new(T)).objectV5()
+	if id > 0 {
+		path += "/" + strconv.Itoa(id)
+	}
+	query := make(url.Values)
+	if method != http.MethodDelete {
+		query.Set("fields", fieldsV5[T](fields))
+	}
+	return requestV5{method: method, path: path, query: query}
+}
+
+// sendObjectV5 issues the request and reads the object returned.
+func sendObjectV5[T objectV5](ctx context.Context, p *Pargo, r requestV5) (T, error) {
+	var v T
+	body, err := p.doV5(ctx, r)
+	if err != nil {
+		return v, err
+	}
+	if err := json.Unmarshal(body, &v); err != nil {
+		return v, errors.Wrap(err, "unmarshaling object")
+	}
+	return v, nil
+}
+
+// ReadV5 reads the object of type T with the id, with the fields given
+// or else every field of T.
+func ReadV5[T objectV5](ctx context.Context, p *Pargo, id int, fields ...string) (T, error) {
+	return sendObjectV5[T](ctx, p, objectV5Request[T](http.MethodGet, id, fields))
+}
+
+// CreateV5 creates an object of type T with values, by field name, and
+// returns it with the fields given or else every field of T.
+func CreateV5[T objectV5](
+	ctx context.Context,
+	p *Pargo,
+	values map[string]interface{},
+	fields ...string,
+) (T, error) {
+	r := objectV5Request[T](http.MethodPost, 0, fields)
+	r.body = values
+	return sendObjectV5[T](ctx, p, r)
+}
+
+// UpdateV5 sets values, by field name, on the object of type T with the
+// id and returns it with the fields given or else every field of T.
+func UpdateV5[T objectV5](
+	ctx context.Context,
+	p *Pargo,
+	id int,
+	values map[string]interface{},
+	fields ...string,
+) (T, error) {
+	r := objectV5Request[T](http.MethodPatch, id, fields)
+	r.body = values
+	return sendObjectV5[T](ctx, p, r)
+}
+
+// DeleteV5 deletes the object of type T with the id.
+func DeleteV5[T objectV5](ctx context.Context, p *Pargo, id int) error {
+	_, err := p.doV5(ctx, objectV5Request[T](http.MethodDelete, id, nil))
+	return err
+}
@@ -0,0 +1,155 @@
+package pargo_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/brunoflores/pargo"
+)
+
+// newV5Client returns a client whose requests to the API are answered
+// by respond with a status code and a body.
+func newV5Client(respond func(req *http.Request) (int, string)) *pargo.Pargo {
+	return newTestClient(newTestHTTPClient(func(req *http.Request) *http.Response {
+		code, body := 200, `{"access_token":"key"}`
+		if req.URL.Host == "pi.pardot.com" {
+			code, body = respond(req)
+		}
+		return &http.Response{
+			StatusCode: code,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+			Header:     make(http.Header)}
+	}))
+}
+
+func TestQueryV5Pager(t *testing.T) {
+	var queries []string
+	client := newV5Client(func(req *http.Request) (int, string) {
+		if req.URL.Path != "/api/v5/objects/prospects" {
+			t.Errorf("got path %s", req.URL.Path)
+		}
+		if got := req.URL.Query().Get("format"); got != "" {
+			t.Errorf("format = %q", got)
+		}
+		queries = append(queries, req.URL.RawQuery)
+		if req.URL.Query().Get("nextPageToken") == "" {
+			return 200, `{"nextPageToken":"abc","values":[
+				{"id":1,"email":"a@b.com","createdAt":"2021-01-02T03:04:05-08:00"},
+				{"id":2,"email":"c@d.com"}]}`
+		}
+		return 200, `{"nextPageToken":null,"values":[{"id":3,"email":"e@f.com"}]}`
+	})
+
+	pager := pargo.QueryV5Pager[pargo.ProspectV5](client, pargo.QueryV5{
+		Fields:  []string{"id", "email", "createdAt"},
+		Limit:   2,
+		OrderBy: "id",
+		Filters: map[string]string{"idGreaterThan": "0"},
+	})
+	var ids []int
+	for prospect, err := range pager.All(context.Background()) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, prospect.ID)
+		if prospect.ID == 1 && prospect.CreatedAt.Hour() != 3 {
+			t.Errorf("got createdAt %v", prospect.CreatedAt)
+		}
+	}
+	if len(ids) != 3 || ids[2] != 3 {
+		t.Fatalf("got %v", ids)
+	}
+	want := []string{
+		"fields=id%2Cemail%2CcreatedAt&idGreaterThan=0&limit=2&orderBy=id",
+		"nextPageToken=abc",
+	}
+	if len(queries) != 2 || queries[0] != want[0] || queries[1] != want[1] {
+		t.Fatalf("got queries %q; want %q", queries, want)
+	}
+}
+
+func TestObjectsV5(t *testing.T) {
+	var got *http.Request
+	var body map[string]interface{}
+	client := newV5Client(func(req *http.Request) (int, string) {
+		got, body = req, nil
+		if req.Body != nil {
+			if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+				t.Error(err)
+			}
+		}
+		if req.Method == http.MethodDelete {
+			return 204, ``
+		}
+		return 200, `{"id":7,"name":"Newsletter","isPublic":true}`
+	})
+	ctx := context.Background()
+
+	list, err := pargo.ReadV5[pargo.ListV5](ctx, client, 7)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Method != "GET" || got.URL.Path != "/api/v5/objects/lists/7" ||
+		got.URL.Query().Get("fields") !=
+			"id,name,title,description,isPublic,isDynamic,campaignId,createdAt,updatedAt" {
+		t.Fatalf("got %s %s", got.Method, got.URL)
+	}
+	if list.Name != "Newsletter" || !list.IsPublic {
+		t.Fatalf("got %+v", list)
+	}
+
+	_, err = pargo.CreateV5[pargo.ListV5](ctx, client,
+		map[string]interface{}{"name": "Newsletter"}, "id")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Method != "POST" || got.URL.Path != "/api/v5/objects/lists" ||
+		got.URL.Query().Get("fields") != "id" || body["name"] != "Newsletter" ||
+		got.Header.Get("Content-Type") != "application/json" {
+		t.Fatalf("got %s %s with %v", got.Method, got.URL, body)
+	}
+
+	_, err = pargo.UpdateV5[pargo.CampaignV5](ctx, client, 4,
+		map[string]interface{}{"cost": 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Method != "PATCH" || got.URL.Path != "/api/v5/objects/campaigns/4" ||
+		body["cost"] != 10.0 {
+		t.Fatalf("got %s %s with %v", got.Method, got.URL, body)
+	}
+
+	if err := pargo.DeleteV5[pargo.ProspectV5](ctx, client, 9); err != nil {
+		t.Fatal(err)
+	}
+	if got.Method != "DELETE" || got.URL.Path != "/api/v5/objects/prospects/9" {
+		t.Fatalf("got %s %s", got.Method, got.URL)
+	}
+}
+
+func TestErrorsV5(t *testing.T) {
+	calls := 0
+	client := newV5Client(func(req *http.Request) (int, string) {
+		calls++
+		if calls == 1 {
+			return 401, `{"code":184,"message":"access_token is invalid"}`
+		}
+		return 400, `{"code":63,"message":"Invalid fields: nope"}`
+	})
+
+	_, err := pargo.ReadV5[pargo.ProspectV5](context.Background(), client, 1, "nope")
+	e, ok := err.(pargo.ErrV5)
+	if !ok {
+		t.Fatalf("got %T %v; want ErrV5", err, err)
+	}
+	if e.StatusCode != 400 || e.Code != 63 || e.Message != "Invalid fields: nope" {
+		t.Fatalf("got %+v", e)
+	}
+	if calls != 2 {
+		t.Fatalf("got %d calls; want a retry after the expired key", calls)
+	}
+}
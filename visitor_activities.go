@@ -0,0 +1,212 @@
+package pargo
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// ActivityType is the kind of a visitor activity, as coded by Pardot.
+type ActivityType int
+
+// Visitor activity types.
+// See http://developer.pardot.com/kb/object-field-references/#visitor-activity.
+const (
+	ActivityClick                ActivityType = 1
+	ActivityView                 ActivityType = 2
+	ActivityError                ActivityType = 3
+	ActivitySuccess              ActivityType = 4
+	ActivitySession              ActivityType = 5
+	ActivitySent                 ActivityType = 6
+	ActivitySearch               ActivityType = 7
+	ActivityNewOpportunity       ActivityType = 8
+	ActivityOpportunityWon       ActivityType = 9
+	ActivityOpportunityLost      ActivityType = 10
+	ActivityOpen                 ActivityType = 11
+	ActivityUnsubscribePage      ActivityType = 12
+	ActivityBounced              ActivityType = 13
+	ActivitySpamComplaint        ActivityType = 14
+	ActivityEmailPreferencePage  ActivityType = 15
+	ActivityResubscribed         ActivityType = 16
+	ActivityClickThirdParty      ActivityType = 17
+	ActivityOpportunityReopened  ActivityType = 18
+	ActivityOpportunityLinked    ActivityType = 19
+	ActivityVisit                ActivityType = 20
+	ActivityCustomURLClick       ActivityType = 21
+	ActivityOlarkChat            ActivityType = 22
+	ActivityInvitedToWebinar     ActivityType = 23
+	ActivityAttendedWebinar      ActivityType = 24
+	ActivityRegisteredForWebinar ActivityType = 25
+	ActivitySocialPostClick      ActivityType = 26
+	ActivityVideoView            ActivityType = 27
+	ActivityEventRegistered      ActivityType = 28
+	ActivityEventCheckedIn       ActivityType = 29
+	ActivityVideoConversion      ActivityType = 30
+	ActivityUserVoiceSuggestion  ActivityType = 31
+	ActivityUserVoiceComment     ActivityType = 32
+	ActivityUserVoiceTicket      ActivityType = 33
+	ActivityVideoWatched         ActivityType = 34
+	ActivityIndirectUnsubscribe  ActivityType = 35
+	ActivityIndirectBounce       ActivityType = 36
+	ActivityIndirectResubscribed ActivityType = 37
+	ActivityOpportunityUnlinked  ActivityType = 38
+)
+
+var activityTypeNames = map[ActivityType]string{
+	ActivityClick:                "Click",
+	ActivityView:                 "View",
+	ActivityError:                "Error",
+	ActivitySuccess:              "Success",
+	ActivitySession:              "Session",
+	ActivitySent:                 "Sent",
+	ActivitySearch:               "Search",
+	ActivityNewOpportunity:       "New Opportunity",
+	ActivityOpportunityWon:       "Opportunity Won",
+	ActivityOpportunityLost:      "Opportunity Lost",
+	ActivityOpen:                 "Open",
+	ActivityUnsubscribePage:      "Unsubscribe Page",
+	ActivityBounced:              "Bounced",
+	ActivitySpamComplaint:        "Spam Complaint",
+	ActivityEmailPreferencePage:  "Email Preference Page",
+	ActivityResubscribed:         "Resubscribed",
+	ActivityClickThirdParty:      "Click (Third Party)",
+	ActivityOpportunityReopened:  "Opportunity Reopened",
+	ActivityOpportunityLinked:    "Opportunity Linked",
+	ActivityVisit:                "Visit",
+	ActivityCustomURLClick:       "Custom URL Click",
+	ActivityOlarkChat:            "Olark Chat",
+	ActivityInvitedToWebinar:     "Invited to Webinar",
+	ActivityAttendedWebinar:      "Attended Webinar",
+	ActivityRegisteredForWebinar: "Registered for Webinar",
+	ActivitySocialPostClick:      "Social Post Click",
+	ActivityVideoView:            "Video View",
+	ActivityEventRegistered:      "Event Registered",
+	ActivityEventCheckedIn:       "Event Checked In",
+	ActivityVideoConversion:      "Video Conversion",
+	ActivityUserVoiceSuggestion:  "UserVoice Suggestion",
+	ActivityUserVoiceComment:     "UserVoice Comment",
+	ActivityUserVoiceTicket:      "UserVoice Ticket",
+	ActivityVideoWatched:         "Video Watched",
+	ActivityIndirectUnsubscribe:  "Indirect Unsubscribe Open",
+	ActivityIndirectBounce:       "Indirect Bounce Notification",
+	ActivityIndirectResubscribed: "Indirect Resubscribed",
+	ActivityOpportunityUnlinked:  "Opportunity Unlinked",
+}
+
+func (t ActivityType) String() string {
+	if name, ok := activityTypeNames[t]; ok {
+		return name
+	}
+	return "ActivityType(" + strconv.Itoa(int(t)) + ")"
+}
+
+// VisitorActivity is something a visitor or prospect did, such as
+// opening an email or submitting a form. Which of the ids are set
+// depends on the Type.
+type VisitorActivity struct {
+	ID                int          `json:"id"`
+	ProspectID        int          `json:"prospect_id,omitempty"`
+	VisitorID         int          `json:"visitor_id,omitempty"`
+	Type              ActivityType `json:"type"`
+	TypeName          string       `json:"type_name"`
+	Details           string       `json:"details"`
+	EmailID           int          `json:"email_id,omitempty"`
+	EmailTemplateID   int          `json:"email_template_id,omitempty"`
+	ListEmailID       int          `json:"list_email_id,omitempty"`
+	FormID            int          `json:"form_id,omitempty"`
+	FormHandlerID     int          `json:"form_handler_id,omitempty"`
+	LandingPageID     int          `json:"landing_page_id,omitempty"`
+	FileID            int          `json:"file_id,omitempty"`
+	CustomRedirectID  int          `json:"custom_redirect_id,omitempty"`
+	VisitorPageViewID int          `json:"visitor_page_view_id,omitempty"`
+	Campaign          *Campaign    `json:"campaign,omitempty"`
+	CreatedAt         Time         `json:"created_at"`
+}
+
+// QueryVisitorActivities is an endpoint to query a page of visitor
+// activities.
+type QueryVisitorActivities struct {
+	Offset, Limit int
+	QueryFilters
+
+	Types       []ActivityType
+	ProspectIDs []int
+	VisitorIDs  []int
+	CampaignID  int
+
+	Placeholder *[]VisitorActivity
+}
+
+// QueryVisitorActivities executes the endpoint with arguments.
+func (p *Pargo) QueryVisitorActivities(args QueryVisitorActivities) error {
+	body, err := p.do(context.Background(), args)
+	if err != nil {
+		return err
+	}
+	activities, err := readRecords[VisitorActivity](body, "visitor_activity")
+	if err != nil {
+		return err
+	}
+	*args.Placeholder = append(*args.Placeholder, activities...)
+	return nil
+}
+
+func (QueryVisitorActivities) Method() string {
+	return http.MethodGet
+}
+
+func (QueryVisitorActivities) Path() string {
+	return "visitorActivity/" + version + "/do/query"
+}
+
+func (q QueryVisitorActivities) Query() (map[string]string, error) {
+	query := q.pageQuery(q.Offset, q.Limit)
+	if len(q.Types) > 0 {
+		types := make([]string, len(q.Types))
+		for i, t := range q.Types {
+			types[i] = strconv.Itoa(int(t))
+		}
+		query["type"] = strings.Join(types, ",")
+	}
+	addInts(query, "prospect_ids", q.ProspectIDs)
+	addInts(query, "visitor_ids", q.VisitorIDs)
+	if q.CampaignID > 0 {
+		query["campaign_id"] = strconv.Itoa(q.CampaignID)
+	}
+	return query, nil
+}
+
+// QueryVisitorActivitiesPager returns a Pager over every visitor
+// activity matching q, by ascending id.
+// The offset and sort order of q are ignored, and its limit is the page
+// size.
+func (p *Pargo) QueryVisitorActivitiesPager(
+	q QueryVisitorActivities,
+) *Pager[VisitorActivity] {
+	start := q.IDGreaterThan
+	return NewKeysetPager[VisitorActivity](p, "visitor_activity", q.Limit,
+		func(after, limit int) Endpoint {
+			q.Offset, q.Limit = 0, limit
+			q.IDGreaterThan = max(after, start)
+			q.SortBy, q.SortOrder = "id", "ascending"
+			return q
+		},
+		func(a VisitorActivity) int { return a.ID })
+}
+
+// QueryAllVisitorActivities is the set of arguments to page through all
+// visitor activities matching Query.
+type QueryAllVisitorActivities struct {
+	Query QueryVisitorActivities
+
+	// Page is required. It is called serially with each page.
+	Page func([]VisitorActivity)
+}
+
+// QueryAllVisitorActivities calls args.Page with every visitor activity
+// matching args.Query, a page at a time by ascending id.
+func (p *Pargo) QueryAllVisitorActivities(args QueryAllVisitorActivities) error {
+	pager := p.QueryVisitorActivitiesPager(args.Query)
+	return pageAll(context.Background(), pager, args.Page)
+}
@@ -0,0 +1,90 @@
+package pargo_test
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/brunoflores/pargo"
+)
+
+func TestActivityTypeString(t *testing.T) {
+	tests := map[pargo.ActivityType]string{
+		pargo.ActivityOpen:                 "Open",
+		pargo.ActivityClick:                "Click",
+		pargo.ActivitySuccess:              "Success",
+		pargo.ActivityOpportunityUnlinked:  "Opportunity Unlinked",
+		pargo.ActivityType(999):            "ActivityType(999)",
+		pargo.ActivityRegisteredForWebinar: "Registered for Webinar",
+	}
+	for typ, want := range tests {
+		if got := typ.String(); got != want {
+			t.Errorf("ActivityType(%d) = %q; want %q", int(typ), got, want)
+		}
+	}
+}
+
+func TestQueryAllVisitorActivities(t *testing.T) {
+	// Activities 1 to 450 of alternating types, filtered by the server.
+	client := newCannedClient(func(req *http.Request) string {
+		if got := req.URL.Path; got != "/api/visitorActivity/version/4/do/query" {
+			t.Errorf("got path %q", got)
+		}
+		for k, want := range map[string]string{
+			"type":        "11,1",
+			"campaign_id": "4",
+			"sort_by":     "id",
+			"sort_order":  "ascending",
+			"offset":      "0",
+		} {
+			if got := req.FormValue(k); got != want {
+				t.Errorf("%s = %q; want %q", k, got, want)
+			}
+		}
+		after, _ := strconv.Atoi(req.FormValue("id_greater_than"))
+		limit, _ := strconv.Atoi(req.FormValue("limit"))
+		var page []string
+		for id := after + 1; id <= 450 && len(page) < limit; id++ {
+			typ := pargo.ActivityOpen
+			if id%2 == 0 {
+				typ = pargo.ActivityClick
+			}
+			page = append(page, fmt.Sprintf(
+				`{"id":%d,"type":%d,"campaign":{"id":4,"name":"Spring"}}`, id, typ))
+		}
+		return `{"result":{"visitor_activity":[` + strings.Join(page, ",") + `]}}`
+	})
+
+	var pages, opens int
+	last := 10
+	err := client.QueryAllVisitorActivities(pargo.QueryAllVisitorActivities{
+		Query: pargo.QueryVisitorActivities{
+			QueryFilters: pargo.QueryFilters{IDGreaterThan: 10},
+			Types:        []pargo.ActivityType{pargo.ActivityOpen, pargo.ActivityClick},
+			CampaignID:   4,
+		},
+		Page: func(activities []pargo.VisitorActivity) {
+			pages++
+			for _, a := range activities {
+				if a.ID != last+1 {
+					t.Fatalf("got activity %d after %d", a.ID, last)
+				}
+				last = a.ID
+				if a.Type == pargo.ActivityOpen {
+					opens++
+				}
+				if a.Campaign == nil || a.Campaign.Name != "Spring" {
+					t.Fatalf("got campaign %+v", a.Campaign)
+				}
+			}
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if last != 450 || pages != 3 || opens != 220 {
+		t.Fatalf("got %d pages up to %d with %d opens", pages, last, opens)
+	}
+}
@@ -0,0 +1,271 @@
+package pargo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+// Visitor is an anonymous or identified visitor of tracked pages.
+type Visitor struct {
+	ID                int    `json:"id"`
+	ProspectID        int    `json:"prospect_id,omitempty"`
+	PageViewCount     int    `json:"page_view_count"`
+	IPAddress         string `json:"ip_address"`
+	Hostname          string `json:"hostname"`
+	CampaignParameter string `json:"campaign_parameter"`
+	MediumParameter   string `json:"medium_parameter"`
+	SourceParameter   string `json:"source_parameter"`
+	ContentParameter  string `json:"content_parameter"`
+	TermParameter     string `json:"term_parameter"`
+	CreatedAt         Time   `json:"created_at"`
+	UpdatedAt         Time   `json:"updated_at"`
+}
+
+// Visit is a session of page views by a visitor.
+type Visit struct {
+	ID                     int              `json:"id"`
+	VisitorID              int              `json:"visitor_id"`
+	ProspectID             int              `json:"prospect_id,omitempty"`
+	VisitorPageViewCount   int              `json:"visitor_page_view_count"`
+	FirstVisitorPageViewAt Time             `json:"first_visitor_page_view_at"`
+	LastVisitorPageViewAt  Time             `json:"last_visitor_page_view_at"`
+	DurationInSeconds      int              `json:"duration_in_seconds"`
+	CampaignParameter      string           `json:"campaign_parameter"`
+	MediumParameter        string           `json:"medium_parameter"`
+	SourceParameter        string           `json:"source_parameter"`
+	ContentParameter       string           `json:"content_parameter"`
+	TermParameter          string           `json:"term_parameter"`
+	VisitorPageViews       VisitorPageViews `json:"visitor_page_views"`
+	CreatedAt              Time             `json:"created_at"`
+	UpdatedAt              Time             `json:"updated_at"`
+}
+
+// VisitorPageView is a page viewed during a visit.
+type VisitorPageView struct {
+	ID        int    `json:"id"`
+	URL       string `json:"url"`
+	Title     string `json:"title"`
+	CreatedAt Time   `json:"created_at"`
+}
+
+// VisitorPageViews are the page views of a visit.
+// Pardot nests them under a key which holds either an array or, when
+// there is only one, a single object.
+type VisitorPageViews []VisitorPageView
+
+func (v *VisitorPageViews) UnmarshalJSON(b []byte) error {
+	var nested struct {
+		PageView json.RawMessage `json:"visitor_page_view"`
+	}
+	if err := json.Unmarshal(b, &nested); err != nil {
+		// An empty set of page views may come as an empty array.
+		var empty []interface{}
+		if json.Unmarshal(b, &empty) == nil && len(empty) == 0 {
+			*v = nil
+			return nil
+		}
+		return errors.Wrap(err, "reading visitor page views")
+	}
+	views, err := decodeRecords[VisitorPageView](nested.PageView)
+	if err != nil {
+		return err
+	}
+	*v = views
+	return nil
+}
+
+// QueryVisitors is an endpoint to query a page of visitors.
+type QueryVisitors struct {
+	Offset, Limit int
+	QueryFilters
+
+	// OnlyIdentified returns only visitors matched to a prospect.
+	OnlyIdentified bool
+	ProspectIDs    []int
+
+	Placeholder *[]Visitor
+}
+
+// QueryVisitors executes the endpoint with arguments.
+func (p *Pargo) QueryVisitors(args QueryVisitors) error {
+	body, err := p.do(context.Background(), args)
+	if err != nil {
+		return err
+	}
+	visitors, err := readRecords[Visitor](body, "visitor")
+	if err != nil {
+		return err
+	}
+	*args.Placeholder = append(*args.Placeholder, visitors...)
+	return nil
+}
+
+func (QueryVisitors) Method() string {
+	return http.MethodGet
+}
+
+func (QueryVisitors) Path() string {
+	return "visitor/" + version + "/do/query"
+}
+
+func (q QueryVisitors) Query() (map[string]string, error) {
+	query := q.pageQuery(q.Offset, q.Limit)
+	if q.OnlyIdentified {
+		query["only_identified"] = "true"
+	}
+	addInts(query, "prospect_ids", q.ProspectIDs)
+	return query, nil
+}
+
+// QueryVisitorsPager returns a Pager over every visitor matching q.
+// The offset of q is ignored and its limit is the page size.
+func (p *Pargo) QueryVisitorsPager(q QueryVisitors) *Pager[Visitor] {
+	return NewPager[Visitor](p, "visitor", q.Limit,
+		func(offset, limit int) Endpoint {
+			q.Offset, q.Limit = offset, limit
+			return q
+		})
+}
+
+// ReadVisitor is an endpoint to read a visitor by id.
+type ReadVisitor struct {
+	ID          int
+	Placeholder *Visitor
+}
+
+// ReadVisitor executes the endpoint with arguments.
+func (p *Pargo) ReadVisitor(args ReadVisitor) error {
+	body, err := p.do(context.Background(), args)
+	if err != nil {
+		return err
+	}
+	return readRecord(body, "visitor", args.Placeholder)
+}
+
+func (ReadVisitor) Method() string {
+	return http.MethodGet
+}
+
+func (q ReadVisitor) Path() string {
+	return fmt.Sprintf("visitor/%s/do/read/id/%d", version, q.ID)
+}
+
+// AssignVisitor is an endpoint to match a visitor to a prospect, given
+// by ProspectID or ProspectEmail.
+// The optional Placeholder receives the visitor assigned.
+type AssignVisitor struct {
+	ID            int
+	ProspectID    int
+	ProspectEmail string
+	Placeholder   *Visitor
+}
+
+// AssignVisitor executes the endpoint with arguments.
+func (p *Pargo) AssignVisitor(args AssignVisitor) error {
+	body, err := p.do(context.Background(), args)
+	if err != nil {
+		return err
+	}
+	return readRecord(body, "visitor", args.Placeholder)
+}
+
+func (AssignVisitor) Method() string {
+	return http.MethodPost
+}
+
+func (q AssignVisitor) Path() string {
+	return fmt.Sprintf("visitor/%s/do/assign/id/%d", version, q.ID)
+}
+
+func (q AssignVisitor) Query() (map[string]string, error) {
+	switch {
+	case q.ProspectID > 0:
+		return map[string]string{
+			"prospect_id": strconv.Itoa(q.ProspectID),
+		}, nil
+	case q.ProspectEmail != "":
+		return map[string]string{"prospect_email": q.ProspectEmail}, nil
+	}
+	return nil, errors.New("missing prospect id or email")
+}
+
+// QueryVisits is an endpoint to query a page of visits.
+// Pardot requires at least one of IDs, VisitorIDs or ProspectIDs.
+type QueryVisits struct {
+	Offset, Limit int
+	IDs           []int
+	VisitorIDs    []int
+	ProspectIDs   []int
+	Placeholder   *[]Visit
+}
+
+// QueryVisits executes the endpoint with arguments.
+func (p *Pargo) QueryVisits(args QueryVisits) error {
+	body, err := p.do(context.Background(), args)
+	if err != nil {
+		return err
+	}
+	visits, err := readRecords[Visit](body, "visit")
+	if err != nil {
+		return err
+	}
+	*args.Placeholder = append(*args.Placeholder, visits...)
+	return nil
+}
+
+func (QueryVisits) Method() string {
+	return http.MethodGet
+}
+
+func (QueryVisits) Path() string {
+	return "visit/" + version + "/do/query"
+}
+
+func (q QueryVisits) Query() (map[string]string, error) {
+	if len(q.IDs)+len(q.VisitorIDs)+len(q.ProspectIDs) == 0 {
+		return nil, errors.New("missing visit, visitor or prospect ids")
+	}
+	query := QueryFilters{}.pageQuery(q.Offset, q.Limit)
+	addInts(query, "ids", q.IDs)
+	addInts(query, "visitor_ids", q.VisitorIDs)
+	addInts(query, "prospect_ids", q.ProspectIDs)
+	return query, nil
+}
+
+// QueryVisitsPager returns a Pager over every visit matching q.
+// The offset of q is ignored and its limit is the page size.
+func (p *Pargo) QueryVisitsPager(q QueryVisits) *Pager[Visit] {
+	return NewPager[Visit](p, "visit", q.Limit,
+		func(offset, limit int) Endpoint {
+			q.Offset, q.Limit = offset, limit
+			return q
+		})
+}
+
+// ReadVisit is an endpoint to read a visit by id.
+type ReadVisit struct {
+	ID          int
+	Placeholder *Visit
+}
+
+// ReadVisit executes the endpoint with arguments.
+func (p *Pargo) ReadVisit(args ReadVisit) error {
+	body, err := p.do(context.Background(), args)
+	if err != nil {
+		return err
+	}
+	return readRecord(body, "visit", args.Placeholder)
+}
+
+func (ReadVisit) Method() string {
+	return http.MethodGet
+}
+
+func (q ReadVisit) Path() string {
+	return fmt.Sprintf("visit/%s/do/read/id/%d", version, q.ID)
+}
@@ -0,0 +1,132 @@
+package pargo_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/brunoflores/pargo"
+)
+
+func TestQueryVisitors(t *testing.T) {
+	var got *http.Request
+	client := newCannedClient(func(req *http.Request) string {
+		got = req
+		return `{"result":{"total_results":1,"visitor":{"id":3,` +
+			`"page_view_count":4,"created_at":"2020-01-01 00:00:00"}}}`
+	})
+	var visitors []pargo.Visitor
+	err := client.QueryVisitors(pargo.QueryVisitors{
+		Limit:          200,
+		OnlyIdentified: true,
+		ProspectIDs:    []int{1, 2},
+		Placeholder:    &visitors,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.URL.Path != "/api/visitor/version/4/do/query" {
+		t.Errorf("got path %q", got.URL.Path)
+	}
+	if v := got.FormValue("only_identified"); v != "true" {
+		t.Errorf("only_identified = %q; want true", v)
+	}
+	if v := got.FormValue("prospect_ids"); v != "1,2" {
+		t.Errorf("prospect_ids = %q; want 1,2", v)
+	}
+	if len(visitors) != 1 || visitors[0].PageViewCount != 4 {
+		t.Fatalf("got %+v", visitors)
+	}
+}
+
+func TestReadAndAssignVisitor(t *testing.T) {
+	var got *http.Request
+	client := newCannedClient(func(req *http.Request) string {
+		got = req
+		return `{"visitor":{"id":3,"prospect_id":9,"ip_address":"10.0.0.1"}}`
+	})
+	var visitor pargo.Visitor
+	if err := client.ReadVisitor(pargo.ReadVisitor{ID: 3, Placeholder: &visitor}); err != nil {
+		t.Fatal(err)
+	}
+	if got.URL.Path != "/api/visitor/version/4/do/read/id/3" ||
+		visitor.IPAddress != "10.0.0.1" {
+		t.Fatalf("got %q and %+v", got.URL.Path, visitor)
+	}
+
+	err := client.AssignVisitor(pargo.AssignVisitor{
+		ID:            3,
+		ProspectEmail: "a@b.com",
+		Placeholder:   &visitor,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Method != "POST" || got.URL.Path != "/api/visitor/version/4/do/assign/id/3" {
+		t.Errorf("got %s %s", got.Method, got.URL.Path)
+	}
+	if v := got.FormValue("prospect_email"); v != "a@b.com" {
+		t.Errorf("prospect_email = %q", v)
+	}
+	if visitor.ProspectID != 9 {
+		t.Errorf("got %+v", visitor)
+	}
+	if err := client.AssignVisitor(pargo.AssignVisitor{ID: 3}); err == nil {
+		t.Fatal("want error without a prospect; got nil")
+	}
+}
+
+func TestVisitPageViews(t *testing.T) {
+	const visits = `{"result":{"total_results":3,"visit":[` +
+		`{"id":1,"visitor_page_views":{"visitor_page_view":` +
+		`{"id":10,"url":"https://a.com/","title":"A"}}},` +
+		`{"id":2,"visitor_page_views":{"visitor_page_view":[` +
+		`{"id":20,"url":"https://b.com/"},{"id":21,"url":"https://b.com/x",` +
+		`"created_at":"2020-01-01 00:00:01"}]}},` +
+		`{"id":3,"visitor_page_views":[]}]}}`
+	var got *http.Request
+	client := newCannedClient(func(req *http.Request) string {
+		got = req
+		return visits
+	})
+	var page []pargo.Visit
+	err := client.QueryVisits(pargo.QueryVisits{
+		Limit:       200,
+		VisitorIDs:  []int{5},
+		Placeholder: &page,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v := got.FormValue("visitor_ids"); v != "5" {
+		t.Errorf("visitor_ids = %q; want 5", v)
+	}
+	if len(page) != 3 {
+		t.Fatalf("got %d visits; want 3", len(page))
+	}
+	if n := len(page[0].VisitorPageViews); n != 1 || page[0].VisitorPageViews[0].Title != "A" {
+		t.Errorf("got %+v", page[0].VisitorPageViews)
+	}
+	if n := len(page[1].VisitorPageViews); n != 2 || page[1].VisitorPageViews[1].CreatedAt.Second() != 1 {
+		t.Errorf("got %+v", page[1].VisitorPageViews)
+	}
+	if n := len(page[2].VisitorPageViews); n != 0 {
+		t.Errorf("got %+v", page[2].VisitorPageViews)
+	}
+
+	err = client.QueryVisits(pargo.QueryVisits{Placeholder: &page})
+	if err == nil {
+		t.Fatal("want error without ids; got nil")
+	}
+
+	var visit pargo.Visit
+	client = newCannedClient(func(req *http.Request) string {
+		got = req
+		return `{"visit":{"id":1,"duration_in_seconds":30}}`
+	})
+	if err := client.ReadVisit(pargo.ReadVisit{ID: 1, Placeholder: &visit}); err != nil {
+		t.Fatal(err)
+	}
+	if got.URL.Path != "/api/visit/version/4/do/read/id/1" || visit.DurationInSeconds != 30 {
+		t.Fatalf("got %q and %+v", got.URL.Path, visit)
+	}
+}